@@ -0,0 +1,15 @@
+# Short-lived key for the duration of the run, never written to the state
+ephemeral "sendgrid_api_key" "deploy" {
+  name   = "deploy-${terraform.workspace}"
+  scopes = ["mail.send"]
+}
+
+# Hand it over to another provider through a write-only argument
+resource "vault_kv_secret_v2" "sendgrid" {
+  mount = "secret"
+  name  = "sendgrid"
+  data_json_wo = jsonencode({
+    api_key = ephemeral.sendgrid_api_key.deploy.api_key
+  })
+  data_json_wo_version = 1
+}
@@ -28,3 +28,12 @@ provider "sendgrid" {
 # provider "sendgrid" {
 #   api_key = "SG.your-api-key-here"  # Don't do this in production!
 # }
+
+# Tuning retries of transient errors (rate limiting, 5xx, network errors)
+# provider "sendgrid" {
+#   max_retries          = 5
+#   retry_base_delay     = "500ms"
+#   retry_max_delay      = "1m"
+#   retry_status_codes   = [429, 502, 503]
+#   retry_network_errors = true
+# }
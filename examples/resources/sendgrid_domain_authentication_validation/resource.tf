@@ -0,0 +1,25 @@
+resource "sendgrid_domain_authentication" "default" {
+  domain = "example.com"
+}
+
+resource "aws_route53_record" "sendgrid" {
+  for_each = { for record in sendgrid_domain_authentication.default.dns : record.host => record }
+
+  zone_id = var.zone_id
+  name    = each.value.host
+  type    = upper(each.value.type)
+  ttl     = 300
+  records = [each.value.data]
+}
+
+# Wait until SendGrid sees the published records
+resource "sendgrid_domain_authentication_validation" "default" {
+  domain_authentication_id = sendgrid_domain_authentication.default.id
+  interval                 = "30s"
+
+  timeouts {
+    create = "45m"
+  }
+
+  depends_on = [aws_route53_record.sendgrid]
+}
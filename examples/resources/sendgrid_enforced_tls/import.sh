@@ -0,0 +1,5 @@
+#!/bin/bash
+
+# Import the enforced TLS setting with "default", or with the subuser
+# name when the provider sets a subuser.
+terraform import sendgrid_enforced_tls.default default
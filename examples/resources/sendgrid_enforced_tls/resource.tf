@@ -0,0 +1,5 @@
+resource "sendgrid_enforced_tls" "default" {
+  require_tls        = true
+  require_valid_cert = true
+  version            = "1.2"
+}
@@ -1,6 +1,5 @@
 #!/bin/bash
 
-# Import an existing event webhook using its ID
-terraform import sendgrid_event_webhook.main webhook-id-12345
-
-# You can find webhook IDs in the SendGrid dashboard under Settings > Mail Settings > Event Webhooks
+# Import an event webhook using its ID, listed by the
+# GET /v3/user/webhooks/event/settings/all endpoint.
+terraform import sendgrid_event_webhook.main 77d4a5da-7015-11ed-a1eb-0242ac120002
@@ -1,7 +1,8 @@
 # Basic event webhook configuration
 resource "sendgrid_event_webhook" "basic" {
-  enabled = true
-  url     = "https://api.myapp.com/sendgrid/events"
+  friendly_name = "basic"
+  enabled       = true
+  url           = "https://api.myapp.com/sendgrid/events"
 
   # Basic email events
   delivered   = true
@@ -22,3 +23,11 @@ resource "sendgrid_event_webhook" "basic" {
   group_resubscribe = true
   group_unsubscribe = true
 }
+
+# Event webhook posting only the listed events
+resource "sendgrid_event_webhook" "engagement" {
+  friendly_name = "engagement"
+  enabled       = true
+  url           = "https://api.myapp.com/sendgrid/engagement"
+  events        = ["open", "click"]
+}
@@ -0,0 +1,4 @@
+# Never send any email to these addresses
+resource "sendgrid_global_suppression" "compliance" {
+  emails = ["do-not-contact@example.com", "legal-hold@example.com"]
+}
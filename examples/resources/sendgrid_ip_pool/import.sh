@@ -0,0 +1,4 @@
+#!/bin/bash
+
+# Import an existing IP pool using its name
+terraform import sendgrid_ip_pool.transactional transactional
@@ -0,0 +1,4 @@
+# Pool of dedicated IP addresses for transactional email
+resource "sendgrid_ip_pool" "transactional" {
+  name = "transactional"
+}
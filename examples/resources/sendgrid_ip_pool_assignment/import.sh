@@ -0,0 +1,4 @@
+#!/bin/bash
+
+# Import the assignment of an IP address to a pool using "<pool name>/<ip>"
+terraform import 'sendgrid_ip_pool_assignment.transactional["192.0.2.10"]' transactional/192.0.2.10
@@ -0,0 +1,19 @@
+resource "sendgrid_ip_pool" "transactional" {
+  name = "transactional"
+}
+
+# Add dedicated IP addresses to the pool
+resource "sendgrid_ip_pool_assignment" "transactional" {
+  for_each = toset(["192.0.2.10", "192.0.2.11"])
+
+  pool_name = sendgrid_ip_pool.transactional.name
+  ip        = each.value
+}
+
+# Give a subuser the IP addresses of the pool
+resource "sendgrid_subuser" "transactional" {
+  username = "transactional"
+  email    = "transactional@example.com"
+  password = var.subuser_password
+  ips      = [for assignment in sendgrid_ip_pool_assignment.transactional : assignment.ip]
+}
@@ -0,0 +1,4 @@
+#!/bin/bash
+
+# Import the warmup of an IP address using the IP address
+terraform import 'sendgrid_ip_warmup.new["192.0.2.10"]' 192.0.2.10
@@ -0,0 +1,11 @@
+data "sendgrid_ips" "new" {
+  assigned = false
+  warmup   = false
+}
+
+# Warm up the dedicated IP addresses not used yet
+resource "sendgrid_ip_warmup" "new" {
+  for_each = toset(data.sendgrid_ips.new.addresses)
+
+  ip = each.value
+}
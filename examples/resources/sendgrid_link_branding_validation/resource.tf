@@ -0,0 +1,20 @@
+resource "sendgrid_link_branding" "default" {
+  domain = "example.com"
+}
+
+resource "aws_route53_record" "sendgrid_links" {
+  for_each = { for record in sendgrid_link_branding.default.dns : record.host => record }
+
+  zone_id = var.zone_id
+  name    = each.value.host
+  type    = upper(each.value.type)
+  ttl     = 300
+  records = [each.value.data]
+}
+
+# Wait until SendGrid sees the published records
+resource "sendgrid_link_branding_validation" "default" {
+  link_branding_id = sendgrid_link_branding.default.id
+
+  depends_on = [aws_route53_record.sendgrid_links]
+}
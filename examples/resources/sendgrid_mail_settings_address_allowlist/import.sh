@@ -0,0 +1,5 @@
+#!/bin/bash
+
+# Import the address allowlist setting with "default", or with the subuser
+# name when the provider sets a subuser.
+terraform import sendgrid_mail_settings_address_allowlist.default default
@@ -0,0 +1,4 @@
+resource "sendgrid_mail_settings_address_allowlist" "default" {
+  enabled = true
+  entries = ["example.com", "ceo@partner.example"]
+}
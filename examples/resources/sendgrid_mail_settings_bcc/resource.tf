@@ -0,0 +1,4 @@
+resource "sendgrid_mail_settings_bcc" "default" {
+  enabled = true
+  email   = "archive@example.com"
+}
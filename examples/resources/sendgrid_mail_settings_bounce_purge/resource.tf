@@ -0,0 +1,5 @@
+resource "sendgrid_mail_settings_bounce_purge" "default" {
+  enabled      = true
+  soft_bounces = 7
+  hard_bounces = 90
+}
@@ -0,0 +1,5 @@
+resource "sendgrid_mail_settings_footer" "default" {
+  enabled       = true
+  html_content  = "<p>Example Inc, 1 Example Street, Denver</p>"
+  plain_content = "Example Inc, 1 Example Street, Denver"
+}
@@ -0,0 +1,5 @@
+#!/bin/bash
+
+# Import the forward bounce setting with "default", or with the subuser
+# name when the provider sets a subuser.
+terraform import sendgrid_mail_settings_forward_bounce.default default
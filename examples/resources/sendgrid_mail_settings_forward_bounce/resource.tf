@@ -0,0 +1,4 @@
+resource "sendgrid_mail_settings_forward_bounce" "default" {
+  enabled = true
+  email   = "bounces@example.com"
+}
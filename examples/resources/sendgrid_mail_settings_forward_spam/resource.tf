@@ -0,0 +1,4 @@
+resource "sendgrid_mail_settings_forward_spam" "default" {
+  enabled = true
+  emails  = ["abuse@example.com", "postmaster@example.com"]
+}
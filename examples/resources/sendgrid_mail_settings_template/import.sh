@@ -0,0 +1,5 @@
+#!/bin/bash
+
+# Import the template setting with "default", or with the subuser
+# name when the provider sets a subuser.
+terraform import sendgrid_mail_settings_template.default default
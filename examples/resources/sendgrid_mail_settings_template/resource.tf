@@ -0,0 +1,4 @@
+resource "sendgrid_mail_settings_template" "default" {
+  enabled      = true
+  html_content = "<html><body><% body %><p>Example Inc</p></body></html>"
+}
@@ -0,0 +1,5 @@
+#!/bin/bash
+
+# Import the New Relic partner setting with "default", or with the subuser
+# name when the provider sets a subuser.
+terraform import sendgrid_partner_settings_new_relic.default default
@@ -0,0 +1,10 @@
+variable "new_relic_license_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "sendgrid_partner_settings_new_relic" "default" {
+  enabled                   = true
+  license_key               = var.new_relic_license_key
+  enable_subuser_statistics = true
+}
@@ -0,0 +1,4 @@
+#!/bin/bash
+
+# Import an existing reverse DNS using its ID
+terraform import sendgrid_reverse_dns.default 123456
@@ -0,0 +1,14 @@
+resource "sendgrid_reverse_dns" "default" {
+  ip        = "192.0.2.10"
+  domain    = "example.com"
+  subdomain = "mail"
+}
+
+# Publish the A record, e.g. with the AWS provider
+resource "aws_route53_record" "reverse_dns" {
+  zone_id = var.zone_id
+  name    = sendgrid_reverse_dns.default.a_record[0].host
+  type    = upper(sendgrid_reverse_dns.default.a_record[0].type)
+  ttl     = 300
+  records = [sendgrid_reverse_dns.default.a_record[0].data]
+}
@@ -0,0 +1,10 @@
+# Deliver again to a mailbox that was fixed after bouncing
+resource "sendgrid_suppression_removal" "support" {
+  list   = "bounces"
+  emails = ["support@example.com"]
+
+  # Change the ticket to remove the emails again
+  triggers = {
+    ticket = "OPS-1234"
+  }
+}
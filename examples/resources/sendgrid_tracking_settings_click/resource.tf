@@ -0,0 +1,4 @@
+# Compliance requires the links of the emails to be left as is
+resource "sendgrid_tracking_settings_click" "default" {
+  enabled = false
+}
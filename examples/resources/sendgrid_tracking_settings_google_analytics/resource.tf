@@ -0,0 +1,6 @@
+resource "sendgrid_tracking_settings_google_analytics" "default" {
+  enabled      = true
+  utm_source   = "sendgrid"
+  utm_medium   = "email"
+  utm_campaign = "newsletter"
+}
@@ -0,0 +1,3 @@
+resource "sendgrid_tracking_settings_open" "default" {
+  enabled = true
+}
@@ -0,0 +1,5 @@
+#!/bin/bash
+
+# Import the subscription tracking setting with "default", or with the subuser
+# name when the provider sets a subuser.
+terraform import sendgrid_tracking_settings_subscription.default default
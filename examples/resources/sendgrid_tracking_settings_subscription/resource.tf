@@ -0,0 +1,6 @@
+resource "sendgrid_tracking_settings_subscription" "default" {
+  enabled       = true
+  html_content  = "<p>Don't want these emails? <% Unsubscribe %>.</p>"
+  plain_content = "Don't want these emails? Unsubscribe: <% %>"
+  landing       = "<html><body><p>You are unsubscribed.</p></body></html>"
+}
@@ -0,0 +1,5 @@
+#!/bin/bash
+
+# Import every email suppressed from an unsubscribe group using its ID.
+# The imported resource is authoritative.
+terraform import sendgrid_unsubscribe_group_suppressions.newsletter 12345
@@ -0,0 +1,11 @@
+resource "sendgrid_unsubscribe_group" "newsletter" {
+  name        = "newsletter"
+  description = "The weekly newsletter"
+}
+
+# Keep the QA seed addresses out of the newsletter, and leave the
+# recipients who unsubscribed themselves alone
+resource "sendgrid_unsubscribe_group_suppressions" "newsletter" {
+  group_id = sendgrid_unsubscribe_group.newsletter.id
+  emails   = ["qa-seed-1@example.com", "qa-seed-2@example.com"]
+}
@@ -0,0 +1,4 @@
+#!/bin/bash
+
+# Import an existing verified sender using its ID
+terraform import sendgrid_verified_sender.support 123456
@@ -0,0 +1,14 @@
+resource "sendgrid_verified_sender" "support" {
+  nickname   = "Support"
+  from_email = "support@example.com"
+  from_name  = "Example Support"
+  reply_to   = "support@example.com"
+  address    = "1 Example Street"
+  city       = "Denver"
+  state      = "Colorado"
+  zip        = "80202"
+  country    = "United States"
+
+  # Change to send the verification email again, e.g. after the link expired
+  verification_trigger = "1"
+}
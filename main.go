@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"log"
 
 	"github.com/arslanbekov/terraform-provider-sendgrid/sendgrid"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 )
 
 // Generate the Terraform provider documentation using `tfplugindocs`:
@@ -16,7 +18,18 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := &plugin.ServeOpts{ProviderFunc: sendgrid.Provider, Debug: debugMode}
+	serverFactory, err := sendgrid.ProviderServerFactory(context.Background(), sendgrid.Provider())
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	plugin.Serve(opts)
+	var serveOpts []tf5server.ServeOpt
+	if debugMode {
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+	}
+
+	err = tf5server.Serve("registry.terraform.io/arslanbekov/sendgrid", serverFactory, serveOpts...)
+	if err != nil {
+		log.Fatal(err)
+	}
 }
@@ -3,6 +3,7 @@ package sendgrid
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -15,28 +16,30 @@ type APIKey struct {
 	Scopes []string `json:"scopes,omitempty"`
 }
 
-func parseAPIKey(respBody string) (*APIKey, RequestError) {
+func parseAPIKey(resp *Response) (*APIKey, RequestError) {
 	var body APIKey
-	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed parsing API key: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
-func parseAPIKeys(respBody string) ([]APIKey, RequestError) {
+func parseAPIKeys(resp *Response) ([]APIKey, RequestError) {
 	var body []APIKey
-	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed parsing API key: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
 // CreateAPIKey creates an APIKey and returns it.
@@ -48,7 +51,7 @@ func (c *Client) CreateAPIKey(ctx context.Context, name string, scopes []string)
 		}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "POST", "/api_keys", APIKey{
+	resp, err := c.Post(ctx, "POST", "/api_keys", APIKey{
 		Name:   name,
 		Scopes: scopes,
 	})
@@ -56,17 +59,19 @@ func (c *Client) CreateAPIKey(ctx context.Context, name string, scopes []string)
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed creating API key: %w", err),
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices {
+	if resp.statusCode() >= http.StatusMultipleChoices {
 		return nil, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingAPIKey, statusCode, respBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingAPIKey, resp.statusCode(), resp.RawBody),
+			Response:   resp,
 		}
 	}
 
-	return parseAPIKey(respBody)
+	return parseAPIKey(resp)
 }
 
 // ReadAPIKey retreives an APIKey and returns it.
@@ -78,27 +83,29 @@ func (c *Client) ReadAPIKey(ctx context.Context, id string) (*APIKey, RequestErr
 		}
 	}
 
-	respBody, _, err := c.Get(ctx, "GET", "/api_keys/"+id)
+	resp, err := c.Get(ctx, "GET", "/api_keys/"+id)
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: http.StatusInternalServerError,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseAPIKey(respBody)
+	return parseAPIKey(resp)
 }
 
 func (c *Client) ReadAPIKeys(ctx context.Context) ([]APIKey, RequestError) {
-	respBody, _, err := c.Get(ctx, "GET", "/api_keys")
+	resp, err := c.Get(ctx, "GET", "/api_keys")
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: http.StatusInternalServerError,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseAPIKeys(respBody)
+	return parseAPIKeys(resp)
 }
 
 // UpdateAPIKey edits an APIKey and returns it.
@@ -119,18 +126,19 @@ func (c *Client) UpdateAPIKey(ctx context.Context, id, name string, scopes []str
 		t.Scopes = scopes
 	}
 
-	respBody, _, err := c.Post(ctx, "PUT", "/api_keys/"+id, t)
+	resp, err := c.Post(ctx, "PUT", "/api_keys/"+id, t)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseAPIKey(respBody)
+	return parseAPIKey(resp)
 }
 
-// DeleteAPIKey deletes an APIKey.
+// DeleteAPIKey deletes an APIKey. An APIKey already deleted is not an error.
 func (c *Client) DeleteAPIKey(ctx context.Context, id string) (bool, RequestError) {
 	if id == "" {
 		return false, RequestError{
@@ -139,20 +147,22 @@ func (c *Client) DeleteAPIKey(ctx context.Context, id string) (bool, RequestErro
 		}
 	}
 
-	responseBody, statusCode, err := c.Get(ctx, "DELETE", "/api_keys/"+id)
-	if err != nil {
+	resp, err := c.Get(ctx, "DELETE", "/api_keys/"+id)
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		return false, RequestError{
-			StatusCode: http.StatusInternalServerError,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+	if resp.statusCode() >= http.StatusMultipleChoices && resp.statusCode() != http.StatusNotFound { // ignore not found
 		return false, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingAPIKey, statusCode, responseBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingAPIKey, resp.statusCode(), resp.RawBody),
+			Response:   resp,
 		}
 	}
 
-	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
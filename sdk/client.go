@@ -1,19 +1,23 @@
 package sendgrid
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/sendgrid/rest"
-	"github.com/sendgrid/sendgrid-go"
+	"github.com/hashicorp/go-cleanhttp"
 )
 
 const (
-	defaultBaseURL = "https://api.sendgrid.com/v3/"
+	defaultBaseURL   = "https://api.sendgrid.com/v3/"
+	defaultUserAgent = "terraform-provider-sendgrid"
 )
 
 // Client is a Sendgrid client.
@@ -24,11 +28,60 @@ type Client struct {
 	apiKey     string
 	host       string
 	OnBehalfOf string
+
+	httpClient  *http.Client
+	rateLimiter *RateLimiter
+	retryPolicy RetryPolicy
+}
+
+// ClientOption customizes a Client created by NewClient.
+type ClientOption func(*Client)
+
+// WithHTTPClient makes the Client send its requests through the given http.Client.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithTransport makes the Client send its requests through the given http.RoundTripper,
+// e.g. to go through a proxy, to use mTLS or to record the traffic. The http.Client
+// given to WithHTTPClient is copied, and keeps its own transport.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		if transport != nil {
+			httpClient := *c.httpClient
+			httpClient.Transport = transport
+			c.httpClient = &httpClient
+		}
+	}
+}
+
+// WithRateLimiter makes the Client share the given RateLimiter, e.g. with other clients
+// talking to the same SendGrid account. A nil RateLimiter disables the throttling.
+func WithRateLimiter(rateLimiter *RateLimiter) ClientOption {
+	return func(c *Client) {
+		c.rateLimiter = rateLimiter
+	}
+}
+
+// WithUserAgent overrides the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		if userAgent != "" {
+			c.UserAgent = userAgent
+		}
+	}
 }
 
 type Response struct {
 	*http.Response
 
+	// RawBody is the body of the response, already read and closed.
+	RawBody string
+
 	// For APIs that support cursor pagination, the following field will be populated
 	// to point to the next page if more results are available.
 	// Set ListCursorParams.Cursor to this value when calling the endpoint again.
@@ -49,16 +102,30 @@ type Rate struct {
 }
 
 // NewClient creates a Sendgrid Client.
-func NewClient(apiKey, host, onBehalfOf string) *Client {
+func NewClient(apiKey, host, onBehalfOf string, opts ...ClientOption) *Client {
 	if host == "" {
 		host = defaultBaseURL
 	}
 
-	return &Client{
-		apiKey:     apiKey,
-		host:       host,
-		OnBehalfOf: onBehalfOf,
+	c := &Client{
+		UserAgent:   defaultUserAgent,
+		apiKey:      apiKey,
+		host:        host,
+		OnBehalfOf:  onBehalfOf,
+		httpClient:  cleanhttp.DefaultPooledClient(),
+		rateLimiter: NewRateLimiter(),
+		retryPolicy: DefaultRetryPolicy(),
+	}
+
+	if baseURL, err := url.Parse(host); err == nil {
+		c.BaseURL = baseURL
 	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
 }
 
 func bodyToJSON(body interface{}) ([]byte, error) {
@@ -74,55 +141,152 @@ func bodyToJSON(body interface{}) ([]byte, error) {
 	return jsonBody, nil
 }
 
-// Get gets a resource from Sendgrid.
-func (c *Client) Get(ctx context.Context, method rest.Method, endpoint string) (string, int, error) {
-	var req rest.Request
-	if c.OnBehalfOf != "" {
-		req = sendgrid.GetRequestSubuser(c.apiKey, endpoint, c.host, c.OnBehalfOf)
-	} else {
-		req = sendgrid.GetRequest(c.apiKey, endpoint, c.host)
+// parseRate extracts the SendGrid rate limit headers of a response.
+func parseRate(header http.Header) Rate {
+	var rate Rate
+
+	if limit := header.Get("X-RateLimit-Limit"); limit != "" {
+		rate.Limit, _ = strconv.Atoi(limit)
 	}
 
-	req.Method = method
+	if remaining := header.Get("X-RateLimit-Remaining"); remaining != "" {
+		rate.Remaining, _ = strconv.Atoi(remaining)
+	}
 
-	resp, err := sendgrid.API(req)
-	if err != nil || resp.StatusCode >= 400 {
-		return "", resp.StatusCode, fmt.Errorf("api response: HTTP %d: %s, err: %v", resp.StatusCode, resp.Body, err)
+	if reset := header.Get("X-RateLimit-Reset"); reset != "" {
+		if epoch, err := strconv.ParseInt(reset, 10, 64); err == nil {
+			rate.Reset = time.Unix(epoch, 0).UTC()
+		}
 	}
 
-	return resp.Body, resp.StatusCode, nil
+	return rate
 }
 
-// Post posts a resource to Sendgrid.
-func (c *Client) Post(ctx context.Context, method rest.Method, endpoint string, body interface{}) (string, int, error) {
-	var err error
+// statusCode returns the HTTP status code of the response, or 0 if no response was received.
+func (r *Response) statusCode() int {
+	if r == nil || r.Response == nil {
+		return 0
+	}
 
-	var req rest.Request
+	return r.StatusCode
+}
 
-	if c.OnBehalfOf != "" {
-		req = sendgrid.GetRequestSubuser(c.apiKey, endpoint, c.host, c.OnBehalfOf)
-	} else {
-		req = sendgrid.GetRequest(c.apiKey, endpoint, c.host)
+func (c *Client) newRequest(ctx context.Context, method, endpoint string, body []byte) (*http.Request, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.host, "/")+endpoint, reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed building request: %w", err)
 	}
 
-	req.Method = method
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	req.Header.Set("User-Agent", c.UserAgent)
+	req.Header.Set("Accept", "application/json")
 
 	if body != nil {
-		req.Body, err = bodyToJSON(body)
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.OnBehalfOf != "" {
+		req.Header.Set("On-Behalf-Of", c.OnBehalfOf)
+	}
+
+	return req, nil
+}
+
+// do sends the request, retrying it according to the RetryPolicy of the Client.
+// An *APIError is returned along with the response if the API answered with an HTTP error.
+func (c *Client) do(req *http.Request, endpoint string) (*Response, error) {
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed rewinding request body: %w", err)
+			}
+
+			req.Body = body
+		}
+
+		resp, err := c.send(req, endpoint)
+		if attempt >= c.retryPolicy.MaxAttempts || !c.retryPolicy.shouldRetry(req.Method, resp, err) {
+			return resp, err
+		}
+
+		delay := c.retryPolicy.delay(attempt)
+		logRetry(req.Context(), req, attempt, delay, resp, err)
+
+		if sleepErr := sleep(req.Context(), delay); sleepErr != nil {
+			return resp, fmt.Errorf("%w (gave up retrying: %w)", err, sleepErr)
+		}
+	}
+}
+
+// send sends the request once and reads the whole response, once the rate limit of the endpoint allows it.
+// An *APIError is returned along with the response if the API answered with an HTTP error.
+func (c *Client) send(req *http.Request, endpoint string) (*Response, error) {
+	family := endpointFamily(endpoint)
+	if err := c.rateLimiter.wait(req.Context(), family); err != nil {
+		return nil, fmt.Errorf("api send %s error: %w", strings.ToLower(req.Method), err)
+	}
+
+	httpResp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("api send %s error: %w", strings.ToLower(req.Method), err)
+	}
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading response body: %w", err)
+	}
+
+	resp := &Response{
+		Response: httpResp,
+		RawBody:  string(body),
+		Rate:     parseRate(httpResp.Header),
 	}
 
+	c.rateLimiter.observe(family, resp)
+
+	if httpResp.StatusCode >= http.StatusBadRequest {
+		return resp, newAPIError(req, resp)
+	}
+
+	return resp, nil
+}
+
+// Get gets a resource from Sendgrid.
+func (c *Client) Get(ctx context.Context, method, endpoint string) (*Response, error) {
+	req, err := c.newRequest(ctx, method, endpoint, nil)
 	if err != nil {
-		return "", 0, fmt.Errorf("failed preparing request body: %w", err)
+		return nil, err
 	}
 
-	resp, err := sendgrid.API(req)
+	return c.do(req, endpoint)
+}
+
+// Post posts a resource to Sendgrid.
+func (c *Client) Post(ctx context.Context, method, endpoint string, body interface{}) (*Response, error) {
+	var (
+		jsonBody []byte
+		err      error
+	)
+
+	if body != nil {
+		jsonBody, err = bodyToJSON(body)
+	}
 
-	if resp.StatusCode >= 400 {
-		return "", resp.StatusCode, fmt.Errorf("api response: HTTP %d: %s", resp.StatusCode, resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed preparing request body: %w", err)
 	}
+
+	req, err := c.newRequest(ctx, method, endpoint, jsonBody)
 	if err != nil {
-		return "", resp.StatusCode, fmt.Errorf("api send post error: %v", err)
+		return nil, err
 	}
 
-	return resp.Body, resp.StatusCode, nil
+	return c.do(req, endpoint)
 }
@@ -0,0 +1,166 @@
+package sendgrid_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+)
+
+func TestClient_Get_headersAndRate(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+			t.Errorf("Authorization = %q, want %q", got, "Bearer secret")
+		}
+
+		if got := r.Header.Get("On-Behalf-Of"); got != "subuser" {
+			t.Errorf("On-Behalf-Of = %q, want %q", got, "subuser")
+		}
+
+		if got := r.Header.Get("User-Agent"); got != "custom-agent" {
+			t.Errorf("User-Agent = %q, want %q", got, "custom-agent")
+		}
+
+		if r.URL.Path != "/templates/abc" {
+			t.Errorf("path = %q, want %q", r.URL.Path, "/templates/abc")
+		}
+
+		w.Header().Set("X-RateLimit-Limit", "600")
+		w.Header().Set("X-RateLimit-Remaining", "599")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		_, _ = w.Write([]byte(`{"id":"abc"}`))
+	}))
+	defer server.Close()
+
+	client := sendgrid.NewClient("secret", server.URL, "subuser", sendgrid.WithUserAgent("custom-agent"))
+
+	resp, err := client.Get(context.Background(), http.MethodGet, "/templates/abc")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if resp.RawBody != `{"id":"abc"}` {
+		t.Errorf("RawBody = %q", resp.RawBody)
+	}
+
+	want := sendgrid.Rate{Limit: 600, Remaining: 599, Reset: time.Unix(1700000000, 0).UTC()}
+	if resp.Rate != want {
+		t.Errorf("Rate = %+v, want %+v", resp.Rate, want)
+	}
+}
+
+func TestClient_Post_httpError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("Content-Type = %q, want application/json", got)
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errors":[{"message":"bad"}]}`))
+	}))
+	defer server.Close()
+
+	client := sendgrid.NewClient("secret", server.URL, "")
+
+	resp, err := client.Post(context.Background(), http.MethodPost, "/api_keys", map[string]string{"name": "x"})
+	if err == nil {
+		t.Fatal("Post() expected an error")
+	}
+
+	if resp == nil || resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("Post() response = %+v, want status 400", resp)
+	}
+}
+
+func TestClient_Get_contextCanceled(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := sendgrid.NewClient("secret", server.URL, "")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.Get(ctx, http.MethodGet, "/scopes")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Get() error = %v, want context.DeadlineExceeded", err)
+	}
+}
+
+type recordingTransport struct {
+	requests []*http.Request
+}
+
+func (rt *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.requests = append(rt.requests, req)
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClient_WithTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	transport := &recordingTransport{}
+	client := sendgrid.NewClient("secret", server.URL, "", sendgrid.WithTransport(transport))
+
+	if _, err := client.Get(context.Background(), http.MethodGet, "/scopes"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if len(transport.requests) != 1 {
+		t.Fatalf("transport saw %d requests, want 1", len(transport.requests))
+	}
+}
+
+func TestClient_WithHTTPClientAndTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	shared := &recordingTransport{}
+	httpClient := &http.Client{Transport: shared, Timeout: time.Minute}
+
+	transport := &recordingTransport{}
+	client := sendgrid.NewClient("secret", server.URL, "",
+		sendgrid.WithHTTPClient(httpClient),
+		sendgrid.WithTransport(transport),
+	)
+
+	if _, err := client.Get(context.Background(), http.MethodGet, "/scopes"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if httpClient.Transport != shared {
+		t.Error("WithTransport changed the transport of the shared http.Client")
+	}
+
+	if len(transport.requests) != 1 || len(shared.requests) != 0 {
+		t.Errorf("transport saw %d requests and shared transport %d, want 1 and 0",
+			len(transport.requests), len(shared.requests))
+	}
+}
@@ -0,0 +1,60 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// DNSValidation is the result of the validation of the DNS records of a domain
+// authentication, link branding or reverse DNS.
+type DNSValidation struct {
+	ID                int32                          `json:"id"`
+	Valid             bool                           `json:"valid"`
+	ValidationResults map[string]DNSRecordValidation `json:"validation_results"` //nolint:tagliatelle
+}
+
+// DNSRecordValidation is the result of the validation of a DNS record, e.g. mail_cname.
+// Reason explains why an invalid record failed.
+type DNSRecordValidation struct {
+	Valid  bool   `json:"valid"`
+	Reason string `json:"reason"`
+}
+
+// InvalidRecords returns the names of the DNS records that failed the validation, sorted.
+func (v DNSValidation) InvalidRecords() []string {
+	var names []string
+
+	for name, result := range v.ValidationResults {
+		if !result.Valid {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// parseDNSValidation parses the response of a validation, which must hold a body.
+func parseDNSValidation(resp *Response) (*DNSValidation, RequestError) {
+	if resp.statusCode() != http.StatusOK {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedValidatingDNSRecords, resp.statusCode(), resp.RawBody),
+			Response:   resp,
+		}
+	}
+
+	var body DNSValidation
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing DNS validation: %w", err),
+			Response:   resp,
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
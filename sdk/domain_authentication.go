@@ -3,6 +3,7 @@ package sendgrid
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -70,7 +71,7 @@ func (c *Client) CreateDomainAuthentication(
 		}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "POST", "/whitelabel/domains", DomainAuthentication{
+	resp, err := c.Post(ctx, "POST", "/whitelabel/domains", DomainAuthentication{
 		Domain:             domain,
 		Subdomain:          subdomain,
 		IPs:                ips,
@@ -83,17 +84,19 @@ func (c *Client) CreateDomainAuthentication(
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed creating domain authentication: %w", err),
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices {
+	if resp.statusCode() >= http.StatusMultipleChoices {
 		return nil, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingDomainAuthentication, statusCode, respBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingDomainAuthentication, resp.statusCode(), resp.RawBody),
+			Response:   resp,
 		}
 	}
 
-	return ParseDomainAuthentication(respBody)
+	return ParseDomainAuthentication(resp.RawBody)
 }
 
 // ReadDomainAuthentication retrieves an DomainAuthentication and returns it.
@@ -105,15 +108,16 @@ func (c *Client) ReadDomainAuthentication(ctx context.Context, id string) (*Doma
 		}
 	}
 
-	respBody, _, err := c.Get(ctx, "GET", "/whitelabel/domains/"+id)
+	resp, err := c.Get(ctx, "GET", "/whitelabel/domains/"+id)
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: http.StatusInternalServerError,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return ParseDomainAuthentication(respBody)
+	return ParseDomainAuthentication(resp.RawBody)
 }
 
 // UpdateDomainAuthentication edits an DomainAuthentication and returns it.
@@ -134,37 +138,41 @@ func (c *Client) UpdateDomainAuthentication(
 	t.IsDefault = isDefault
 	t.CustomSPF = customSPF
 
-	respBody, _, err := c.Post(ctx, "PATCH", "/whitelabel/domains/"+id, t)
+	resp, err := c.Post(ctx, "PATCH", "/whitelabel/domains/"+id, t)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return ParseDomainAuthentication(respBody)
+	return ParseDomainAuthentication(resp.RawBody)
 }
 
-func (c *Client) ValidateDomainAuthentication(ctx context.Context, id string) RequestError {
+// ValidateDomainAuthentication asks SendGrid to check the DNS records of a DomainAuthentication,
+// and returns the result for each of them.
+func (c *Client) ValidateDomainAuthentication(ctx context.Context, id string) (*DNSValidation, RequestError) {
 	if id == "" {
-		return RequestError{
+		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        ErrDomainAuthenticationIDRequired,
 		}
 	}
 
-	_, statusCode, err := c.Post(ctx, "POST", "/whitelabel/domains/"+id+"/validate", nil)
-	if err != nil || statusCode != 200 {
-		return RequestError{
-			StatusCode: statusCode,
+	resp, err := c.Post(ctx, "POST", "/whitelabel/domains/"+id+"/validate", nil)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return RequestError{StatusCode: http.StatusOK, Err: nil}
+	return parseDNSValidation(resp)
 }
 
-// DeleteDomainAuthentication deletes an DomainAuthentication.
+// DeleteDomainAuthentication deletes an DomainAuthentication. A DomainAuthentication already deleted is not an error.
 func (c *Client) DeleteDomainAuthentication(ctx context.Context, id string) (bool, RequestError) {
 	if id == "" {
 		return false, RequestError{
@@ -173,21 +181,22 @@ func (c *Client) DeleteDomainAuthentication(ctx context.Context, id string) (boo
 		}
 	}
 
-	responseBody, statusCode, err := c.Get(ctx, "DELETE", "/whitelabel/domains/"+id)
-	if err != nil {
+	resp, err := c.Get(ctx, "DELETE", "/whitelabel/domains/"+id)
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		return false, RequestError{
-			StatusCode: http.StatusInternalServerError,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+	if resp.statusCode() >= http.StatusMultipleChoices && resp.statusCode() != http.StatusNotFound { // ignore not found
 		return false, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err: fmt.Errorf("%w, status: %d, response: %s",
-				ErrFailedDeletingDomainAuthentication, statusCode, responseBody),
+				ErrFailedDeletingDomainAuthentication, resp.statusCode(), resp.RawBody),
 		}
 	}
 
-	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
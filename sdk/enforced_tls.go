@@ -0,0 +1,43 @@
+package sendgrid
+
+import (
+	"context"
+	"net/http"
+)
+
+const enforcedTLSPath = "/user/settings/enforced_tls"
+
+// EnforcedTLS is the TLS required from the servers receiving the emails. An email
+// whose recipient server doesn't meet it is dropped rather than sent in clear text.
+type EnforcedTLS struct {
+	RequireTLS       bool `json:"require_tls"`        //nolint:tagliatelle
+	RequireValidCert bool `json:"require_valid_cert"` //nolint:tagliatelle
+	// Version is the minimum TLS version, e.g. 1.2. 0 keeps the current version.
+	Version float64 `json:"version,omitempty"`
+}
+
+// enforcedTLSDisabled is the body of the requests turning the enforced TLS off,
+// leaving its version as is.
+type enforcedTLSDisabled struct {
+	RequireTLS       bool `json:"require_tls"`        //nolint:tagliatelle
+	RequireValidCert bool `json:"require_valid_cert"` //nolint:tagliatelle
+}
+
+// ReadEnforcedTLS retrieves the enforced TLS setting.
+func (c *Client) ReadEnforcedTLS(ctx context.Context) (*EnforcedTLS, RequestError) {
+	return readSetting[EnforcedTLS](ctx, c, enforcedTLSPath)
+}
+
+// UpdateEnforcedTLS changes the enforced TLS setting and returns it.
+func (c *Client) UpdateEnforcedTLS(ctx context.Context, s EnforcedTLS) (*EnforcedTLS, RequestError) {
+	return patchSetting[EnforcedTLS](ctx, c, enforcedTLSPath, s)
+}
+
+// DisableEnforcedTLS stops requiring TLS and valid certificates, leaving the version as is.
+func (c *Client) DisableEnforcedTLS(ctx context.Context) (bool, RequestError) {
+	if _, err := patchSetting[EnforcedTLS](ctx, c, enforcedTLSPath, enforcedTLSDisabled{}); err.Err != nil {
+		return false, err
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
@@ -7,9 +7,10 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var (
@@ -78,6 +79,18 @@ var (
 
 	ErrFailedPatchingEventWebhook = errors.New("failed to patch event webhook")
 
+	// ErrUnknownEventType error displayed when an event type isn't one an event webhook can post.
+	ErrUnknownEventType = errors.New("unknown event type")
+
+	// ErrEventWebhookIDRequired error displayed when an event webhook ID wasn't specified.
+	ErrEventWebhookIDRequired = errors.New("event webhook id is required")
+
+	// ErrFailedCreatingEventWebhook error displayed when the provider can not create an event webhook.
+	ErrFailedCreatingEventWebhook = errors.New("failed to create event webhook")
+
+	// ErrFailedDeletingEventWebhook error displayed when the provider can not delete an event webhook.
+	ErrFailedDeletingEventWebhook = errors.New("failed to delete event webhook")
+
 	ErrFailedCreatingDomainAuthentication = errors.New("failed to create domain authentication")
 
 	ErrDomainAuthenticationIDRequired = errors.New("id for domain authentication is required")
@@ -90,6 +103,42 @@ var (
 
 	ErrFailedCreatingLinkBranding = errors.New("failed to create link branding")
 
+	// ErrReverseDNSIDRequired error displayed when a reverse DNS ID wasn't specified.
+	ErrReverseDNSIDRequired = errors.New("reverse DNS id is required")
+
+	// ErrFailedCreatingReverseDNS error displayed when the provider can not create a reverse DNS.
+	ErrFailedCreatingReverseDNS = errors.New("failed to create reverse DNS")
+
+	// ErrFailedDeletingReverseDNS error displayed when the provider can not delete a reverse DNS.
+	ErrFailedDeletingReverseDNS = errors.New("failed to delete reverse DNS")
+
+	// ErrFailedValidatingDNSRecords error displayed when SendGrid didn't return the validation of DNS records.
+	ErrFailedValidatingDNSRecords = errors.New("failed to validate DNS records")
+
+	// ErrVerifiedSenderIDRequired error displayed when a verified sender ID wasn't specified.
+	ErrVerifiedSenderIDRequired = errors.New("verified sender id is required")
+
+	// ErrFromEmailRequired error displayed when a verified sender from email wasn't specified.
+	ErrFromEmailRequired = errors.New("a from email is required")
+
+	// ErrFailedCreatingVerifiedSender error displayed when the provider can not create a verified sender.
+	ErrFailedCreatingVerifiedSender = errors.New("failed creating verified sender")
+
+	// ErrFailedDeletingVerifiedSender error displayed when the provider can not delete a verified sender.
+	ErrFailedDeletingVerifiedSender = errors.New("failed deleting verified sender")
+
+	// ErrFailedResendingVerification error displayed when the provider can not resend the verification email.
+	ErrFailedResendingVerification = errors.New("failed resending the verification email")
+
+	// ErrFailedAddingSuppressions error displayed when the provider can not add suppressions.
+	ErrFailedAddingSuppressions = errors.New("failed adding suppressions")
+
+	// ErrFailedDeletingSuppression error displayed when the provider can not delete a suppression.
+	ErrFailedDeletingSuppression = errors.New("failed deleting suppression")
+
+	// ErrFailedUpdatingSetting error displayed when the provider can not update a setting.
+	ErrFailedUpdatingSetting = errors.New("failed updating setting")
+
 	// ErrSubUserPassword should be empty.
 	ErrSubUserPassword = errors.New("new password must be non empty")
 
@@ -110,57 +159,165 @@ var (
 
 	// ErrFailedUpdatingSSOCertificate error displayed when an SSO certificate update request fails.
 	ErrFailedUpdatingSSOCertificate = errors.New("failed to update SSO certificate")
+
+	// ErrIPPoolNameRequired error displayed when an IP pool name wasn't specified.
+	ErrIPPoolNameRequired = errors.New("an IP pool name is required")
+
+	// ErrIPAddressRequired error displayed when an IP address wasn't specified.
+	ErrIPAddressRequired = errors.New("an IP address is required")
+
+	// ErrFailedCreatingIPPool error displayed when the provider can not create an IP pool.
+	ErrFailedCreatingIPPool = errors.New("failed creating IP pool")
+
+	// ErrFailedDeletingIPPool error displayed when the provider can not delete an IP pool.
+	ErrFailedDeletingIPPool = errors.New("failed deleting IP pool")
+
+	// ErrFailedStartingIPWarmup error displayed when the provider can not start the warmup of an IP address.
+	ErrFailedStartingIPWarmup = errors.New("failed starting IP warmup")
+
+	// ErrFailedStoppingIPWarmup error displayed when the provider can not stop the warmup of an IP address.
+	ErrFailedStoppingIPWarmup = errors.New("failed stopping IP warmup")
+
+	// ErrFailedAddingIPToPool error displayed when the provider can not add an IP address to a pool.
+	ErrFailedAddingIPToPool = errors.New("failed adding IP address to pool")
+
+	// ErrFailedRemovingIPFromPool error displayed when the provider can not remove an IP address from a pool.
+	ErrFailedRemovingIPFromPool = errors.New("failed removing IP address from pool")
+
+	// ErrBadRequest matches an APIError with the HTTP status 400.
+	ErrBadRequest = errors.New("bad request")
+
+	// ErrUnauthorized matches an APIError with the HTTP status 401.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrForbidden matches an APIError with the HTTP status 403.
+	ErrForbidden = errors.New("forbidden")
+
+	// ErrNotFound matches an APIError with the HTTP status 404.
+	ErrNotFound = errors.New("not found")
+
+	// ErrRateLimited matches an APIError with the HTTP status 429.
+	ErrRateLimited = errors.New("rate limited")
+
+	// ErrServerError matches an APIError with an HTTP status 5xx.
+	ErrServerError = errors.New("server error")
 )
 
+// APIError is an error response of the SendGrid API.
+// Use errors.Is with ErrNotFound, ErrRateLimited, etc. to check its kind.
 type APIError struct {
+	// StatusCode is the HTTP status of the response.
+	StatusCode int
+
+	// Method and Path identify the request that failed.
+	Method string
+	Path   string
+
+	// RequestID is the X-Request-Id header of the response, useful when contacting SendGrid support.
+	RequestID string
+
+	// Errors are the details listed by SendGrid in the errors array of the response.
+	Errors []APIErrorDetail
+
+	// Body is the raw response body.
+	Body string
+
+	// Rate is the rate limit of the endpoint, as of the response.
+	Rate Rate
+
 	f interface{} // unknown
 }
 
+// APIErrorDetail is one item of the errors array of a SendGrid error response.
+type APIErrorDetail struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message,omitempty"`
+	ErrorID string `json:"error_id,omitempty"` //nolint:tagliatelle
+}
+
+// newAPIError builds the APIError of a response with an HTTP error status.
+func newAPIError(req *http.Request, resp *Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		Body:       resp.RawBody,
+		Rate:       resp.Rate,
+	}
+
+	if resp.RawBody != "" {
+		_ = json.Unmarshal([]byte(resp.RawBody), apiErr)
+	}
+
+	return apiErr
+}
+
 // RequestError struct permits to embed to return the statucode and the error to the parent function.
+// StatusCode is the status of the API response, or 0 when SendGrid didn't answer, e.g. on a network error.
+// RetryOnRateLimit retries when StatusCode is 429 or Err is ErrRateLimited, and words the other errors after StatusCode.
+// Response holds the metadata of the last response, e.g. its rate limit, once SendGrid answered,
+// whether the request failed or not.
 type RequestError struct {
 	StatusCode int
 	Err        error
+	Response   *Response
 }
 
-type subUserError struct {
-	Field   string `json:"field,omitempty"`
-	Message string `json:"message,omitempty"`
+// Rate returns the rate limit of the last response, or the zero Rate when SendGrid didn't answer.
+func (e RequestError) Rate() Rate {
+	if e.Response == nil {
+		return Rate{}
+	}
+
+	return e.Response.Rate
+}
+
+// RequestID returns the X-Request-Id of the last response, to share with the SendGrid support.
+func (e RequestError) RequestID() string {
+	if e.Response == nil || e.Response.Response == nil {
+		return ""
+	}
+
+	return e.Response.Header.Get("X-Request-Id")
 }
 
 type subUserErrors struct {
-	Errors []subUserError `json:"errors,omitempty"`
+	Errors []APIErrorDetail `json:"errors,omitempty"`
 }
 
 // parseErrorDetails attempts to parse SendGrid API error response for better error messages
 func parseErrorDetails(err error) (string, bool) {
-	errStr := err.Error()
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return "", false
+	}
 
+	switch {
 	// Check for scope-related errors
-	if strings.Contains(errStr, "invalid or unassignable scopes") {
+	case apiErr.hasMessage("invalid or unassignable scopes"):
 		return `invalid or unassignable scopes provided. This can happen when:
 1. Using invalid scope names (check SendGrid API documentation)
 2. Your SendGrid plan doesn't support certain scopes
 3. Including automatically managed scopes like '2fa_exempt' or '2fa_required'
 
 Tip: Run 'terraform plan' first to validate your configuration`, true
-	}
 
 	// Check for permission errors
-	if strings.Contains(errStr, "permission") || strings.Contains(errStr, "unauthorized") {
+	case errors.Is(apiErr, ErrUnauthorized), errors.Is(apiErr, ErrForbidden):
 		return `permission denied. Check that:
 1. Your API key has sufficient permissions
 2. You're not trying to access features not available in your SendGrid plan
 3. The API key hasn't been revoked or expired`, true
-	}
 
 	// Check for resource not found
-	if strings.Contains(errStr, "not found") || strings.Contains(errStr, "404") {
+	case errors.Is(apiErr, ErrNotFound):
 		return "resource not found. It may have been deleted outside of Terraform or the ID is incorrect", true
-	}
 
 	// Check for validation errors
-	if strings.Contains(errStr, "validation") || strings.Contains(errStr, "invalid") {
-		return "validation error. Please check that all required fields are provided and values are in the correct format", true
+	case errors.Is(apiErr, ErrBadRequest) && len(apiErr.Errors) > 0:
+		return "validation error. Please check that all required fields are provided and values are in the correct format:\n" +
+			apiErr.Detail(), true
 	}
 
 	return "", false
@@ -172,6 +329,11 @@ func enhanceError(originalErr error, statusCode int) error {
 		return nil
 	}
 
+	var apiErr *APIError
+	if errors.As(originalErr, &apiErr) {
+		statusCode = apiErr.StatusCode
+	}
+
 	// Try to parse for specific error details
 	if enhancedMsg, enhanced := parseErrorDetails(originalErr); enhanced {
 		return fmt.Errorf("%s\n\nOriginal error: %w", enhancedMsg, originalErr)
@@ -179,6 +341,14 @@ func enhanceError(originalErr error, statusCode int) error {
 
 	// Provide context based on status code
 	switch statusCode {
+	case 0:
+		return fmt.Errorf(`no response from the SendGrid API. This usually means a network error:
+1. Check your network connection and proxy settings
+2. Check that the provider host is correct
+3. Retry the operation: the provider already retried it according to its retry policy
+
+Original error: %w`, originalErr)
+
 	case http.StatusBadRequest:
 		return fmt.Errorf(`bad request (HTTP 400). This usually means:
 1. Invalid input data or parameters
@@ -236,22 +406,67 @@ Original error: %w`, statusCode, originalErr)
 	}
 }
 
-// RetryOnRateLimit management of RequestErrors, and launch a retry if needed.
+// responseFields returns the log fields of the metadata of a response.
+func responseFields(requestErr RequestError) map[string]interface{} {
+	rate := requestErr.Rate()
+
+	fields := map[string]interface{}{
+		"status_code":          requestErr.Response.statusCode(),
+		"rate_limit":           rate.Limit,
+		"rate_limit_remaining": rate.Remaining,
+	}
+
+	if id := requestErr.RequestID(); id != "" {
+		fields["request_id"] = id
+	}
+
+	if !rate.Reset.IsZero() {
+		fields["rate_limit_reset"] = rate.Reset.Format(time.RFC3339)
+	}
+
+	return fields
+}
+
+// rateLimitReset tells when the rate limit of the last attempt resets, if it was rate limited.
+func rateLimitReset(rate *Rate) string {
+	if rate == nil || rate.Reset.IsZero() {
+		return ""
+	}
+
+	return fmt.Sprintf("\nThe last attempt was rate limited. SendGrid allows %d requests per window, and the window resets at %s.\n",
+		rate.Limit, rate.Reset.Format(time.RFC3339))
+}
+
+// RetryOnRateLimit management of RequestErrors, and launch a retry if needed
+// until the timeout of the current operation, e.g. d.Timeout(schema.TimeoutDelete).
+// Transient server and network errors are already retried by the Client according to its RetryPolicy,
+// this keeps retrying rate limited calls until the timeout.
 // Enhanced with better error handling and more informative error messages.
 func RetryOnRateLimit(
-	ctx context.Context, d *schema.ResourceData, f func() (interface{}, RequestError),
+	ctx context.Context, timeout time.Duration, f func() (interface{}, RequestError),
 ) (interface{}, error) {
-	var resp interface{}
+	var (
+		resp        interface{}
+		rateLimited *Rate
+	)
 
 	err := retry.RetryContext(
 		ctx,
-		d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		timeout, func() *retry.RetryError {
 			var requestErr RequestError
 			resp, requestErr = f()
+			rateLimited = nil
+
+			if requestErr.Response != nil {
+				tflog.Debug(ctx, "SendGrid response", responseFields(requestErr))
+			}
 
 			if requestErr.Err != nil {
 				// Always retry rate limit errors
-				if requestErr.StatusCode == http.StatusTooManyRequests {
+				if requestErr.StatusCode == http.StatusTooManyRequests || errors.Is(requestErr.Err, ErrRateLimited) {
+					rate := requestErr.Rate()
+					rateLimited = &rate
+
 					return retry.RetryableError(requestErr.Err)
 				}
 
@@ -265,9 +480,8 @@ func RetryOnRateLimit(
 
 	if err != nil {
 		// Check for context cancellation
-		if strings.Contains(err.Error(), "context canceled") ||
-			strings.Contains(err.Error(), "operation was canceled") ||
-			strings.Contains(err.Error(), "context deadline exceeded") {
+		var timeoutErr *retry.TimeoutError
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.As(err, &timeoutErr) {
 			return resp, fmt.Errorf(`operation was canceled or timed out. This can happen when:
 1. You pressed Ctrl+C during execution
 2. The operation took longer than the configured timeout
@@ -277,11 +491,12 @@ What to do next:
 1. Check your SendGrid dashboard for partially created resources
 2. Run 'terraform refresh' to update the state
 3. Re-run the operation with increased timeout if needed
-
-Original error: %w`, err)
+%s
+Original error: %w`, rateLimitReset(rateLimited), err)
 		}
 
-		return resp, fmt.Errorf("request failed: %w", err)
+		// A retried rate limit error is returned as is once the timeout is reached.
+		return resp, fmt.Errorf("request failed: %w%s", err, strings.TrimRight(rateLimitReset(rateLimited), "\n"))
 	}
 
 	return resp, nil
@@ -290,7 +505,17 @@ Original error: %w`, err)
 func (e *APIError) UnmarshalJSON(b []byte) error {
 	if err := json.Unmarshal(b, &e.f); err != nil {
 		e.f = string(b)
+
+		return nil
+	}
+
+	var body struct {
+		Errors []APIErrorDetail `json:"errors"`
 	}
+	if err := json.Unmarshal(b, &body); err == nil {
+		e.Errors = body.Errors
+	}
+
 	return nil
 }
 
@@ -298,7 +523,21 @@ func (e *APIError) MarshalJSON() ([]byte, error) {
 	return json.Marshal(e.f)
 }
 
+// Detail returns the messages SendGrid gave about the error.
 func (e APIError) Detail() string {
+	if len(e.Errors) > 0 {
+		details := make([]string, 0, len(e.Errors))
+		for _, detail := range e.Errors {
+			if detail.Field != "" {
+				details = append(details, detail.Field+": "+detail.Message)
+			} else {
+				details = append(details, detail.Message)
+			}
+		}
+
+		return strings.Join(details, "; ")
+	}
+
 	switch v := e.f.(type) {
 	case map[string]interface{}:
 		if len(v) == 1 {
@@ -307,16 +546,64 @@ func (e APIError) Detail() string {
 			}
 		}
 		return fmt.Sprintf("%v", v)
+	case nil:
+		return http.StatusText(e.StatusCode)
 	default:
 		return fmt.Sprintf("%v", v)
 	}
 }
 
 func (e APIError) Error() string {
-	return fmt.Sprintf("sendgrid: %s", e.Detail())
+	msg := "sendgrid: "
+	if e.Method != "" {
+		msg += e.Method + " " + e.Path + ": "
+	}
+
+	if e.StatusCode != 0 {
+		msg += fmt.Sprintf("HTTP %d: ", e.StatusCode)
+	}
+
+	msg += e.Detail()
+
+	if e.RequestID != "" {
+		msg += " (request id: " + e.RequestID + ")"
+	}
+
+	return msg
+}
+
+// Is makes errors.Is match the sentinel error of the HTTP status, e.g. ErrNotFound for a 404.
+func (e APIError) Is(target error) bool {
+	switch target { //nolint:errorlint
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.StatusCode >= http.StatusInternalServerError
+	default:
+		return false
+	}
 }
 
 // Empty returns true if empty.
 func (e APIError) Empty() bool {
-	return e.f == nil
+	return e.f == nil && e.StatusCode == 0 && len(e.Errors) == 0
+}
+
+// hasMessage tells whether one of the error messages contains the given text.
+func (e APIError) hasMessage(text string) bool {
+	for _, detail := range e.Errors {
+		if strings.Contains(detail.Message, text) {
+			return true
+		}
+	}
+
+	return false
 }
@@ -0,0 +1,264 @@
+package sendgrid_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+)
+
+func errorServer(t *testing.T, statusCode int, body string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestAPIError(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		wantIs     []error
+		wantIsNot  []error
+		wantErrors []sendgrid.APIErrorDetail
+		wantDetail string
+	}{
+		{
+			name:       "not found",
+			statusCode: http.StatusNotFound,
+			body:       `{"errors":[{"field":null,"message":"resource not found"}]}`,
+			wantIs:     []error{sendgrid.ErrNotFound},
+			wantIsNot:  []error{sendgrid.ErrForbidden, sendgrid.ErrRateLimited, sendgrid.ErrServerError},
+			wantErrors: []sendgrid.APIErrorDetail{{Message: "resource not found"}},
+			wantDetail: "resource not found",
+		},
+		{
+			name:       "validation errors",
+			statusCode: http.StatusBadRequest,
+			body:       `{"errors":[{"field":"name","message":"is required","error_id":"required"},{"field":"scopes","message":"invalid or unassignable scopes"}]}`, //nolint:lll
+			wantIs:     []error{sendgrid.ErrBadRequest},
+			wantIsNot:  []error{sendgrid.ErrNotFound},
+			wantErrors: []sendgrid.APIErrorDetail{
+				{Field: "name", Message: "is required", ErrorID: "required"},
+				{Field: "scopes", Message: "invalid or unassignable scopes"},
+			},
+			wantDetail: "name: is required; scopes: invalid or unassignable scopes",
+		},
+		{
+			name:       "forbidden with detail",
+			statusCode: http.StatusForbidden,
+			body:       `{"detail":"access forbidden"}`,
+			wantIs:     []error{sendgrid.ErrForbidden},
+			wantIsNot:  []error{sendgrid.ErrUnauthorized},
+			wantDetail: "access forbidden",
+		},
+		{
+			name:       "server error without body",
+			statusCode: http.StatusServiceUnavailable,
+			wantIs:     []error{sendgrid.ErrServerError},
+			wantIsNot:  []error{sendgrid.ErrBadRequest},
+			wantDetail: "Service Unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := errorServer(t, tt.statusCode, tt.body)
+			client := sendgrid.NewClient("secret", server.URL, "", sendgrid.WithRetryPolicy(sendgrid.RetryPolicy{}))
+
+			_, err := client.Get(context.Background(), http.MethodDelete, "/templates/abc")
+
+			var apiErr *sendgrid.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("error = %v, want an *APIError", err)
+			}
+
+			if apiErr.StatusCode != tt.statusCode || apiErr.Method != http.MethodDelete ||
+				apiErr.Path != "/templates/abc" || apiErr.RequestID != "req-123" || apiErr.Body != tt.body {
+				t.Errorf("APIError = %+v", apiErr)
+			}
+
+			if !reflect.DeepEqual(apiErr.Errors, tt.wantErrors) {
+				t.Errorf("Errors = %+v, want %+v", apiErr.Errors, tt.wantErrors)
+			}
+
+			if got := apiErr.Detail(); got != tt.wantDetail {
+				t.Errorf("Detail() = %q, want %q", got, tt.wantDetail)
+			}
+
+			for _, target := range tt.wantIs {
+				if !errors.Is(err, target) {
+					t.Errorf("errors.Is(%v, %v) = false", err, target)
+				}
+			}
+
+			for _, target := range tt.wantIsNot {
+				if errors.Is(err, target) {
+					t.Errorf("errors.Is(%v, %v) = true", err, target)
+				}
+			}
+
+			if !strings.Contains(err.Error(), "request id: req-123") {
+				t.Errorf("Error() = %q, should mention the request id", err.Error())
+			}
+		})
+	}
+}
+
+func TestRetryOnRateLimit_enhancesAPIErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		want       string
+	}{
+		{
+			name:       "invalid scopes",
+			statusCode: http.StatusBadRequest,
+			body:       `{"errors":[{"field":"scopes","message":"invalid or unassignable scopes"}]}`,
+			want:       "invalid or unassignable scopes provided",
+		},
+		{
+			name:       "forbidden",
+			statusCode: http.StatusForbidden,
+			body:       `{"errors":[{"message":"access forbidden"}]}`,
+			want:       "permission denied",
+		},
+		{
+			name:       "not found",
+			statusCode: http.StatusNotFound,
+			body:       `{"errors":[{"message":"no such template"}]}`,
+			want:       "resource not found",
+		},
+		{
+			name:       "field validation",
+			statusCode: http.StatusBadRequest,
+			body:       `{"errors":[{"field":"name","message":"is too long"}]}`,
+			want:       "name: is too long",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := errorServer(t, tt.statusCode, tt.body)
+			client := sendgrid.NewClient("secret", server.URL, "", sendgrid.WithRetryPolicy(sendgrid.RetryPolicy{}))
+
+			_, err := sendgrid.RetryOnRateLimit(context.Background(), time.Minute, func() (interface{}, sendgrid.RequestError) {
+				resp, err := client.Get(context.Background(), http.MethodGet, "/templates/abc")
+
+				// The status code is deliberately wrong, as in many SDK calls: the APIError must win.
+				return resp, sendgrid.RequestError{StatusCode: http.StatusInternalServerError, Err: err}
+			})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("RetryOnRateLimit() error = %v, want it to contain %q", err, tt.want)
+			}
+
+			if !errors.Is(err, sendgrid.ErrNotFound) && tt.statusCode == http.StatusNotFound {
+				t.Errorf("RetryOnRateLimit() error should still match ErrNotFound")
+			}
+		})
+	}
+}
+
+func TestRequestError_response(t *testing.T) {
+	t.Parallel()
+
+	reset := time.Now().Add(time.Minute).Truncate(time.Second).UTC()
+
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		wantErr    error
+	}{
+		{name: "success", statusCode: http.StatusOK, body: `{"api_key_id":"key-id","name":"ci"}`},
+		{name: "not found", statusCode: http.StatusNotFound, body: `{"errors":[{"message":"not found"}]}`, wantErr: sendgrid.ErrNotFound},
+		{name: "rate limited", statusCode: http.StatusTooManyRequests, body: `{"errors":[{"message":"too many requests"}]}`, wantErr: sendgrid.ErrRateLimited},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("X-Request-Id", "req-123")
+				w.Header().Set("X-RateLimit-Limit", "600")
+				w.Header().Set("X-RateLimit-Remaining", "0")
+				w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			t.Cleanup(server.Close)
+
+			client := sendgrid.NewClient("secret", server.URL, "", sendgrid.WithRetryPolicy(sendgrid.RetryPolicy{}))
+
+			_, requestErr := client.ReadAPIKey(context.Background(), "key-id")
+			if !errors.Is(requestErr.Err, tt.wantErr) {
+				t.Fatalf("ReadAPIKey() error = %v, want %v", requestErr.Err, tt.wantErr)
+			}
+
+			if requestErr.Response == nil {
+				t.Fatal("ReadAPIKey() returned no response")
+			}
+
+			wantRate := sendgrid.Rate{Limit: 600, Remaining: 0, Reset: reset}
+			if got := requestErr.Rate(); got != wantRate {
+				t.Errorf("Rate() = %+v, want %+v", got, wantRate)
+			}
+
+			if got := requestErr.RequestID(); got != "req-123" {
+				t.Errorf("RequestID() = %q, want req-123", got)
+			}
+
+			var apiErr *sendgrid.APIError
+			if tt.wantErr != nil && (!errors.As(requestErr.Err, &apiErr) || apiErr.Rate != wantRate) {
+				t.Errorf("APIError = %+v, want the rate %+v", apiErr, wantRate)
+			}
+		})
+	}
+}
+
+func TestRetryOnRateLimit_timeoutTellsRateLimitReset(t *testing.T) {
+	t.Parallel()
+
+	reset := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "3")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(server.Close)
+
+	client := sendgrid.NewClient("secret", server.URL, "", sendgrid.WithRetryPolicy(sendgrid.RetryPolicy{}))
+
+	_, err := sendgrid.RetryOnRateLimit(context.Background(), 100*time.Millisecond, func() (interface{}, sendgrid.RequestError) {
+		return client.ReadAPIKey(context.Background(), "key-id")
+	})
+	if err == nil || !strings.Contains(err.Error(), "window resets at "+reset.Format(time.RFC3339)) {
+		t.Errorf("RetryOnRateLimit() error = %v, want it to tell when the rate limit resets", err)
+	}
+}
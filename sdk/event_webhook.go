@@ -5,10 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 )
 
-// EventWebhook is a Sendgrid event webhook settings.
+// EventWebhook is a Sendgrid event webhook settings. An account can have several
+// event webhooks, each with its own ID and signing key.
 type EventWebhook struct { //nolint:maligned
+	ID                string `json:"id,omitempty"`
+	FriendlyName      string `json:"friendly_name"` //nolint:tagliatelle
 	Enabled           bool   `json:"enabled"`
 	URL               string `json:"url,omitempty"`
 	GroupResubscribe  bool   `json:"group_resubscribe"` //nolint:tagliatelle
@@ -25,123 +29,425 @@ type EventWebhook struct { //nolint:maligned
 	OAuthClientID     string `json:"oauth_client_id,omitempty"`     //nolint:tagliatelle
 	OAuthClientSecret string `json:"oauth_client_secret,omitempty"` //nolint:tagliatelle
 	OAuthTokenURL     string `json:"oauth_token_url,omitempty"`     //nolint:tagliatelle
+	// PublicKey is the key verifying the signature of the events, only set when they are signed.
+	PublicKey string `json:"public_key,omitempty"` //nolint:tagliatelle
 }
 
+// eventWebhooks is the listing of the event webhooks.
+type eventWebhooks struct {
+	MaxAllowed int            `json:"max_allowed"` //nolint:tagliatelle
+	Webhooks   []EventWebhook `json:"webhooks"`
+}
+
+// EventType is a type of event an event webhook can post.
+type EventType string
+
+const (
+	// EventProcessed is posted when a message is received and ready to be delivered.
+	EventProcessed EventType = "processed"
+	// EventDropped is posted when a message is not delivered, e.g. to a suppressed recipient.
+	EventDropped EventType = "dropped"
+	// EventDelivered is posted when the receiving server accepts a message.
+	EventDelivered EventType = "delivered"
+	// EventDeferred is posted when the receiving server temporarily rejects a message.
+	EventDeferred EventType = "deferred"
+	// EventBounce is posted when the receiving server permanently rejects a message.
+	EventBounce EventType = "bounce"
+	// EventOpen is posted when a recipient opens a message. It requires the open tracking.
+	EventOpen EventType = "open"
+	// EventClick is posted when a recipient clicks a link. It requires the click tracking.
+	EventClick EventType = "click"
+	// EventSpamReport is posted when a recipient marks a message as spam.
+	EventSpamReport EventType = "spam_report"
+	// EventUnsubscribe is posted when a recipient unsubscribes from every email.
+	EventUnsubscribe EventType = "unsubscribe"
+	// EventGroupUnsubscribe is posted when a recipient unsubscribes from an unsubscribe group.
+	EventGroupUnsubscribe EventType = "group_unsubscribe"
+	// EventGroupResubscribe is posted when a recipient subscribes to an unsubscribe group again.
+	EventGroupResubscribe EventType = "group_resubscribe"
+)
+
+// EventTypes returns every type of event an event webhook can post.
+func EventTypes() []EventType {
+	return []EventType{
+		EventProcessed,
+		EventDropped,
+		EventDelivered,
+		EventDeferred,
+		EventBounce,
+		EventOpen,
+		EventClick,
+		EventSpamReport,
+		EventUnsubscribe,
+		EventGroupUnsubscribe,
+		EventGroupResubscribe,
+	}
+}
+
+// Posts returns whether the event webhook posts the events of the type.
+func (w EventWebhook) Posts(event EventType) bool {
+	switch event {
+	case EventProcessed:
+		return w.Processed
+	case EventDropped:
+		return w.Dropped
+	case EventDelivered:
+		return w.Delivered
+	case EventDeferred:
+		return w.Deferred
+	case EventBounce:
+		return w.Bounce
+	case EventOpen:
+		return w.Open
+	case EventClick:
+		return w.Click
+	case EventSpamReport:
+		return w.SpamReport
+	case EventUnsubscribe:
+		return w.Unsubscribe
+	case EventGroupUnsubscribe:
+		return w.GroupUnsubscribe
+	case EventGroupResubscribe:
+		return w.GroupResubscribe
+	}
+
+	return false
+}
+
+// Events returns the types of events the event webhook posts.
+func (w EventWebhook) Events() []EventType {
+	var events []EventType
+
+	for _, event := range EventTypes() {
+		if w.Posts(event) {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+// EventWebhookRequest is the body of the requests creating or changing an event
+// webhook. Only its non-nil fields are sent, so a change leaves the other fields of
+// the webhook as is.
+type EventWebhookRequest struct { //nolint:maligned
+	FriendlyName      *string `json:"friendly_name,omitempty"` //nolint:tagliatelle
+	Enabled           *bool   `json:"enabled,omitempty"`
+	URL               *string `json:"url,omitempty"`
+	GroupResubscribe  *bool   `json:"group_resubscribe,omitempty"` //nolint:tagliatelle
+	Delivered         *bool   `json:"delivered,omitempty"`
+	GroupUnsubscribe  *bool   `json:"group_unsubscribe,omitempty"` //nolint:tagliatelle
+	SpamReport        *bool   `json:"spam_report,omitempty"`       //nolint:tagliatelle
+	Bounce            *bool   `json:"bounce,omitempty"`
+	Deferred          *bool   `json:"deferred,omitempty"`
+	Unsubscribe       *bool   `json:"unsubscribe,omitempty"`
+	Processed         *bool   `json:"processed,omitempty"`
+	Open              *bool   `json:"open,omitempty"`
+	Click             *bool   `json:"click,omitempty"`
+	Dropped           *bool   `json:"dropped,omitempty"`
+	OAuthClientID     *string `json:"oauth_client_id,omitempty"`     //nolint:tagliatelle
+	OAuthClientSecret *string `json:"oauth_client_secret,omitempty"` //nolint:tagliatelle
+	OAuthTokenURL     *string `json:"oauth_token_url,omitempty"`     //nolint:tagliatelle
+}
+
+// eventFlag returns the field of the request selecting the events of the type.
+func (r *EventWebhookRequest) eventFlag(event EventType) (**bool, bool) {
+	flags := map[EventType]**bool{
+		EventProcessed:        &r.Processed,
+		EventDropped:          &r.Dropped,
+		EventDelivered:        &r.Delivered,
+		EventDeferred:         &r.Deferred,
+		EventBounce:           &r.Bounce,
+		EventOpen:             &r.Open,
+		EventClick:            &r.Click,
+		EventSpamReport:       &r.SpamReport,
+		EventUnsubscribe:      &r.Unsubscribe,
+		EventGroupUnsubscribe: &r.GroupUnsubscribe,
+		EventGroupResubscribe: &r.GroupResubscribe,
+	}
+
+	flag, ok := flags[event]
+
+	return flag, ok
+}
+
+// SelectEvents sets every event flag of the request: the webhook posts the events of
+// the given types, and no other.
+func (r *EventWebhookRequest) SelectEvents(events ...EventType) error {
+	for _, event := range events {
+		if _, ok := r.eventFlag(event); !ok {
+			return fmt.Errorf("%w: %q", ErrUnknownEventType, event)
+		}
+	}
+
+	for _, event := range EventTypes() {
+		flag, _ := r.eventFlag(event)
+		selected := slices.Contains(events, event)
+		*flag = &selected
+	}
+
+	return nil
+}
+
+// EventWebhookSigning is the signing of the events posted by an event webhook.
 type EventWebhookSigning struct {
+	ID        string `json:"id,omitempty"`
 	Enabled   bool   `json:"enabled"`
 	PublicKey string `json:"public_key"` //nolint:tagliatelle
 }
 
-func parseEventWebhook(respBody string) (*EventWebhook, RequestError) {
+func parseEventWebhook(resp *Response) (*EventWebhook, RequestError) {
 	var body EventWebhook
-	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed parsing event webhook: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
-func parseEventWebhookSigning(respBody string) (*EventWebhookSigning, RequestError) {
+func parseEventWebhookSigning(resp *Response) (*EventWebhookSigning, RequestError) {
 	var body EventWebhookSigning
-	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed parsing event webhook: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
-// CreateEventWebhook creates an EventWebhook and returns it.
-func (c *Client) PatchEventWebhook(ctx context.Context, enabled bool, url string, groupResubscribe bool, delivered bool, groupUnsubscribe bool, spamReport bool, bounce bool, deferred bool, unsubscribe bool, processed bool, open bool, click bool, dropped bool, oauthClientID string, oauthClientSecret string, oauthTokenURL string) (*EventWebhook, RequestError) {
-	if url == "" {
+// PatchEventWebhook changes the oldest EventWebhook of the account, or creates it
+// when there is none, and returns it. Only the non-nil fields of the request are changed.
+func (c *Client) PatchEventWebhook(ctx context.Context, request EventWebhookRequest) (*EventWebhook, RequestError) {
+	resp, err := c.Post(ctx, "PATCH", "/user/webhooks/event/settings", request)
+	if err != nil {
 		return nil, RequestError{
-			StatusCode: http.StatusInternalServerError,
-			Err:        ErrURLRequired,
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedPatchingEventWebhook, err),
+			Response:   resp,
 		}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "PATCH", "/user/webhooks/event/settings", EventWebhook{
-		Enabled:           enabled,
-		URL:               url,
-		GroupResubscribe:  groupResubscribe,
-		Delivered:         delivered,
-		GroupUnsubscribe:  groupUnsubscribe,
-		SpamReport:        spamReport,
-		Bounce:            bounce,
-		Deferred:          deferred,
-		Unsubscribe:       unsubscribe,
-		Processed:         processed,
-		Open:              open,
-		Click:             click,
-		Dropped:           dropped,
-		OAuthClientID:     oauthClientID,
-		OAuthClientSecret: oauthClientSecret,
-		OAuthTokenURL:     oauthTokenURL,
+	return parseEventWebhook(resp)
+}
+
+// ReadEventWebhook retrieves the oldest EventWebhook of the account and returns it.
+func (c *Client) ReadEventWebhook(ctx context.Context) (*EventWebhook, RequestError) {
+	resp, err := c.Get(ctx, "GET", "/user/webhooks/event/settings")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        err,
+			Response:   resp,
+		}
+	}
+
+	return parseEventWebhook(resp)
+}
+
+// ConfigureEventWebhookSigning turns the signing of the oldest EventWebhook of the
+// account on or off.
+func (c *Client) ConfigureEventWebhookSigning(ctx context.Context, enabled bool) (*EventWebhookSigning, RequestError) {
+	resp, err := c.Post(ctx, "PATCH", "/user/webhooks/event/settings/signed", EventWebhookSigning{
+		Enabled: enabled,
 	})
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed patching event webhook: %w", err),
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices {
+	if resp.statusCode() >= http.StatusMultipleChoices {
 		return nil, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedPatchingEventWebhook, statusCode, respBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedPatchingEventWebhook, resp.statusCode(), resp.RawBody),
+			Response:   resp,
 		}
 	}
 
-	return parseEventWebhook(respBody)
+	return parseEventWebhookSigning(resp)
 }
 
-// ReadEventWebhook retrieves an EventWebhook and returns it.
-func (c *Client) ReadEventWebhook(ctx context.Context) (*EventWebhook, RequestError) {
-	respBody, _, err := c.Get(ctx, "GET", "/user/webhooks/event/settings")
+// ReadEventWebhookSigning retrieves the signing of the oldest EventWebhook of the account.
+func (c *Client) ReadEventWebhookSigning(ctx context.Context) (*EventWebhookSigning, RequestError) {
+	resp, err := c.Get(ctx, "GET", "/user/webhooks/event/settings/signed")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        err,
+			Response:   resp,
+		}
+	}
+
+	return parseEventWebhookSigning(resp)
+}
+
+// CreateEventWebhook creates an EventWebhook and returns it. The fields left nil take
+// the defaults of SendGrid.
+func (c *Client) CreateEventWebhook(ctx context.Context, request EventWebhookRequest) (*EventWebhook, RequestError) {
+	if request.URL == nil || *request.URL == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrURLRequired,
+		}
+	}
+
+	resp, err := c.Post(ctx, "POST", "/user/webhooks/event/settings", request)
 	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedCreatingEventWebhook, err),
+			Response:   resp,
+		}
+	}
+
+	return parseEventWebhook(resp)
+}
+
+// ReadEventWebhookByID retrieves an EventWebhook and returns it.
+func (c *Client) ReadEventWebhookByID(ctx context.Context, id string) (*EventWebhook, RequestError) {
+	if id == "" {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEventWebhookIDRequired,
+		}
+	}
+
+	resp, err := c.Get(ctx, "GET", "/user/webhooks/event/settings/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseEventWebhook(respBody)
+	return parseEventWebhook(resp)
 }
 
-func (c *Client) ConfigureEventWebhookSigning(ctx context.Context, enabled bool) (*EventWebhookSigning, RequestError) {
-	respBody, statusCode, err := c.Post(ctx, "PATCH", "/user/webhooks/event/settings/signed", EventWebhookSigning{
-		Enabled: enabled,
-	})
+// ReadEventWebhooks retrieves all the EventWebhooks of the account, oldest first.
+func (c *Client) ReadEventWebhooks(ctx context.Context) ([]EventWebhook, RequestError) {
+	resp, err := c.Get(ctx, "GET", "/user/webhooks/event/settings/all")
 	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        err,
+			Response:   resp,
+		}
+	}
+
+	var body eventWebhooks
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
-			Err:        fmt.Errorf("failed patching event webhook: %w", err),
+			Err:        fmt.Errorf("failed parsing event webhooks: %w", err),
+			Response:   resp,
+		}
+	}
+
+	return body.Webhooks, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
+
+// UpdateEventWebhook changes an EventWebhook and returns it. Only the non-nil fields
+// of the request are changed.
+func (c *Client) UpdateEventWebhook(
+	ctx context.Context,
+	id string,
+	request EventWebhookRequest,
+) (*EventWebhook, RequestError) {
+	if id == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEventWebhookIDRequired,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices {
+	resp, err := c.Post(ctx, "PATCH", "/user/webhooks/event/settings/"+id, request)
+	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedPatchingEventWebhook, statusCode, respBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedPatchingEventWebhook, err),
+			Response:   resp,
 		}
 	}
 
-	return parseEventWebhookSigning(respBody)
+	return parseEventWebhook(resp)
 }
 
-func (c *Client) ReadEventWebhookSigning(ctx context.Context) (*EventWebhookSigning, RequestError) {
-	respBody, _, err := c.Get(ctx, "GET", "/user/webhooks/event/settings/signed")
+// DeleteEventWebhook deletes an EventWebhook.
+func (c *Client) DeleteEventWebhook(ctx context.Context, id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEventWebhookIDRequired,
+		}
+	}
+
+	resp, err := c.Get(ctx, "DELETE", "/user/webhooks/event/settings/"+id)
+	if err != nil && resp.statusCode() != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedDeletingEventWebhook, err),
+			Response:   resp,
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
+
+// ConfigureEventWebhookSigningByID turns the signing of an EventWebhook on or off.
+// SendGrid generates a new key pair every time the signing is turned on.
+func (c *Client) ConfigureEventWebhookSigningByID(
+	ctx context.Context,
+	id string,
+	enabled bool,
+) (*EventWebhookSigning, RequestError) {
+	if id == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEventWebhookIDRequired,
+		}
+	}
+
+	resp, err := c.Post(ctx, "PATCH", "/user/webhooks/event/settings/signed/"+id, EventWebhookSigning{
+		Enabled: enabled,
+	})
 	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedPatchingEventWebhook, err),
+			Response:   resp,
+		}
+	}
+
+	return parseEventWebhookSigning(resp)
+}
+
+// ReadEventWebhookSigningByID retrieves the signing of an EventWebhook.
+func (c *Client) ReadEventWebhookSigningByID(ctx context.Context, id string) (*EventWebhookSigning, RequestError) {
+	if id == "" {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEventWebhookIDRequired,
+		}
+	}
+
+	resp, err := c.Get(ctx, "GET", "/user/webhooks/event/settings/signed/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseEventWebhookSigning(respBody)
+	return parseEventWebhookSigning(resp)
 }
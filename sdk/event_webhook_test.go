@@ -0,0 +1,79 @@
+package sendgrid_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+)
+
+func TestEventWebhookRequestSelectEvents(t *testing.T) {
+	t.Parallel()
+
+	var request sendgrid.EventWebhookRequest
+	if err := request.SelectEvents(sendgrid.EventOpen, sendgrid.EventClick); err != nil {
+		t.Fatalf("SelectEvents() error = %v", err)
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		t.Fatalf("failed encoding the request: %v", err)
+	}
+
+	want := `{"group_resubscribe":false,"delivered":false,"group_unsubscribe":false,"spam_report":false,` +
+		`"bounce":false,"deferred":false,"unsubscribe":false,"processed":false,"open":true,"click":true,"dropped":false}`
+	if string(body) != want {
+		t.Errorf("request = %s, want %s", body, want)
+	}
+}
+
+func TestEventWebhookRequestSelectEvents_unknown(t *testing.T) {
+	t.Parallel()
+
+	var request sendgrid.EventWebhookRequest
+
+	err := request.SelectEvents(sendgrid.EventOpen, "opened")
+	if !errors.Is(err, sendgrid.ErrUnknownEventType) {
+		t.Fatalf("SelectEvents() error = %v, want %v", err, sendgrid.ErrUnknownEventType)
+	}
+
+	if request.Open != nil {
+		t.Error("SelectEvents() changed the request despite the unknown event")
+	}
+}
+
+func TestEventWebhookRequest_partial(t *testing.T) {
+	t.Parallel()
+
+	enabled := false
+
+	body, err := json.Marshal(sendgrid.EventWebhookRequest{Enabled: &enabled})
+	if err != nil {
+		t.Fatalf("failed encoding the request: %v", err)
+	}
+
+	if want := `{"enabled":false}`; string(body) != want {
+		t.Errorf("request = %s, want %s", body, want)
+	}
+}
+
+func TestEventWebhookEvents(t *testing.T) {
+	t.Parallel()
+
+	webhook := sendgrid.EventWebhook{Delivered: true, Bounce: true, GroupResubscribe: true}
+
+	want := []sendgrid.EventType{sendgrid.EventDelivered, sendgrid.EventBounce, sendgrid.EventGroupResubscribe}
+	if got := webhook.Events(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Events() = %v, want %v", got, want)
+	}
+
+	if webhook.Posts(sendgrid.EventOpen) {
+		t.Error("Posts(open) = true, want false")
+	}
+
+	if len(sendgrid.EventTypes()) != 11 {
+		t.Errorf("EventTypes() has %d types, want 11", len(sendgrid.EventTypes()))
+	}
+}
@@ -0,0 +1,400 @@
+// Package inboundparse parses the emails posted by the SendGrid inbound parse
+// webhook, e.g. the one configured by the sendgrid_parse_webhook resource.
+//
+// SendGrid posts each email as a multipart form. By default the email is already
+// split in fields, e.g. text, html and attachment1. With send_raw, the whole MIME
+// message is posted in the email field instead, and is parsed here. Either way the
+// email is returned as an *Email:
+//
+//	parser := inboundparse.New(inboundparse.WithMaxAttachmentSize(10 << 20))
+//
+//	http.Handle("/inbound", parser.Handler(func(ctx context.Context, email *inboundparse.Email) error {
+//		log.Printf("%s wrote %q", email.Envelope.From, email.Subject)
+//
+//		return nil
+//	}))
+package inboundparse
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultMaxBodySize is the size of the largest request the parser reads, in bytes.
+	// SendGrid posts emails up to 30 MB.
+	DefaultMaxBodySize = 30 << 20
+	// DefaultMaxAttachmentSize is the size of the largest attachment, in bytes.
+	DefaultMaxAttachmentSize = 20 << 20
+)
+
+var (
+	// ErrNotMultipart error displayed when the request isn't a multipart form.
+	ErrNotMultipart = errors.New("request must be a multipart form")
+
+	// ErrBodyTooLarge error displayed when the request exceeds the maximum size.
+	ErrBodyTooLarge = errors.New("body too large")
+
+	// ErrAttachmentTooLarge error displayed when an attachment exceeds the maximum size.
+	ErrAttachmentTooLarge = errors.New("attachment too large")
+
+	// ErrInvalidField error displayed when a field posted by SendGrid can't be parsed.
+	ErrInvalidField = errors.New("invalid field")
+)
+
+// Envelope is the SMTP envelope of an email, which may differ from its headers, e.g.
+// for a blind copy.
+type Envelope struct {
+	To   []string `json:"to"`
+	From string   `json:"from"`
+}
+
+// DKIMResult is the result of the verification of a DKIM signature of an email.
+type DKIMResult struct {
+	Domain string
+	Result string
+}
+
+// Attachment is a file attached to an email, or embedded in its HTML.
+type Attachment struct {
+	// Field is the form field of the attachment in the default mode, e.g. attachment1.
+	Field       string
+	Filename    string
+	ContentType string
+	// ContentID is set when the HTML of the email embeds the attachment.
+	ContentID string
+	Content   []byte
+}
+
+// Email is an email received by the inbound parse webhook.
+type Email struct {
+	Headers mail.Header
+	From    string
+	To      string
+	Cc      string
+	Subject string
+	Text    string
+	HTML    string
+
+	Envelope Envelope
+	SenderIP string
+	// SPF is the result of the SPF check, e.g. pass.
+	SPF  string
+	DKIM []DKIMResult
+	// Charsets are the charsets of the fields, e.g. {"text": "UTF-8"}. The fields are
+	// returned as posted, without conversion.
+	Charsets map[string]string
+
+	// SpamScore and SpamReport are only set when spam_check is enabled.
+	SpamScore  *float64
+	SpamReport string
+
+	Attachments []Attachment
+
+	// Raw is the whole MIME message when send_raw is enabled.
+	Raw []byte
+}
+
+// Parser parses the requests posted by the inbound parse webhook.
+type Parser struct {
+	maxBodySize       int64
+	maxAttachmentSize int64
+}
+
+// Option customizes a Parser created by New.
+type Option func(*Parser)
+
+// WithMaxBodySize sets the size of the largest request the Parser reads, in bytes.
+func WithMaxBodySize(maxBodySize int64) Option {
+	return func(p *Parser) {
+		if maxBodySize > 0 {
+			p.maxBodySize = maxBodySize
+		}
+	}
+}
+
+// WithMaxAttachmentSize sets the size of the largest attachment, in bytes.
+func WithMaxAttachmentSize(maxAttachmentSize int64) Option {
+	return func(p *Parser) {
+		if maxAttachmentSize > 0 {
+			p.maxAttachmentSize = maxAttachmentSize
+		}
+	}
+}
+
+// New creates a Parser.
+func New(opts ...Option) *Parser {
+	p := &Parser{
+		maxBodySize:       DefaultMaxBodySize,
+		maxAttachmentSize: DefaultMaxAttachmentSize,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// Parse reads the email posted in a request, in the default or the raw mode.
+func (p *Parser) Parse(r *http.Request) (*Email, error) {
+	r.Body = http.MaxBytesReader(nil, r.Body, p.maxBodySize)
+
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNotMultipart, err)
+	}
+
+	values := map[string]string{}
+
+	var files []Attachment
+
+	for {
+		part, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, p.readError(err)
+		}
+
+		if part.FileName() == "" {
+			value, err := io.ReadAll(part)
+			if err != nil {
+				return nil, p.readError(err)
+			}
+
+			values[part.FormName()] = string(value)
+
+			continue
+		}
+
+		content, err := p.readAttachment(part, part.FileName())
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, Attachment{
+			Field:       part.FormName(),
+			Filename:    part.FileName(),
+			ContentType: part.Header.Get("Content-Type"),
+			Content:     content,
+		})
+	}
+
+	email, err := parseFields(values)
+	if err != nil {
+		return nil, err
+	}
+
+	if raw, ok := values["email"]; ok {
+		email.Raw = []byte(raw)
+
+		if err := p.parseRaw(email); err != nil {
+			return nil, err
+		}
+
+		return email, nil
+	}
+
+	if err := email.addAttachments(values, files); err != nil {
+		return nil, err
+	}
+
+	return email, nil
+}
+
+// readError wraps an error reading the request, telling apart a request too large.
+func (p *Parser) readError(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return fmt.Errorf("%w: more than %d bytes", ErrBodyTooLarge, p.maxBodySize)
+	}
+
+	return fmt.Errorf("failed reading the request: %w", err)
+}
+
+// readAttachment reads an attachment up to the maximum size.
+func (p *Parser) readAttachment(r io.Reader, filename string) ([]byte, error) {
+	content, err := io.ReadAll(io.LimitReader(r, p.maxAttachmentSize+1))
+	if err != nil {
+		return nil, p.readError(err)
+	}
+
+	if int64(len(content)) > p.maxAttachmentSize {
+		return nil, fmt.Errorf("%w: %s is more than %d bytes", ErrAttachmentTooLarge, filename, p.maxAttachmentSize)
+	}
+
+	return content, nil
+}
+
+// parseFields parses the fields posted in both modes.
+func parseFields(values map[string]string) (*Email, error) {
+	email := &Email{
+		From:       values["from"],
+		To:         values["to"],
+		Cc:         values["cc"],
+		Subject:    values["subject"],
+		Text:       values["text"],
+		HTML:       values["html"],
+		SenderIP:   values["sender_ip"],
+		SPF:        values["SPF"],
+		DKIM:       parseDKIM(values["dkim"]),
+		SpamReport: values["spam_report"],
+	}
+
+	if err := unmarshalField(values, "envelope", &email.Envelope); err != nil {
+		return nil, err
+	}
+
+	if err := unmarshalField(values, "charsets", &email.Charsets); err != nil {
+		return nil, err
+	}
+
+	if score, ok := values["spam_score"]; ok && score != "" {
+		value, err := strconv.ParseFloat(score, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: spam_score: %w", ErrInvalidField, err)
+		}
+
+		email.SpamScore = &value
+	}
+
+	if headers, ok := values["headers"]; ok {
+		// The headers end with a single line break, and need an empty line to end the block.
+		reader := textproto.NewReader(bufio.NewReader(strings.NewReader(strings.TrimRight(headers, "\r\n") + "\r\n\r\n")))
+
+		header, err := reader.ReadMIMEHeader()
+		if err != nil {
+			return nil, fmt.Errorf("%w: headers: %w", ErrInvalidField, err)
+		}
+
+		email.Headers = mail.Header(header)
+	}
+
+	return email, nil
+}
+
+// unmarshalField decodes a JSON field, if it is posted.
+func unmarshalField(values map[string]string, name string, v interface{}) error {
+	value, ok := values[name]
+	if !ok || value == "" {
+		return nil
+	}
+
+	if err := json.Unmarshal([]byte(value), v); err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrInvalidField, name, err)
+	}
+
+	return nil
+}
+
+// parseDKIM parses the DKIM results, e.g. "{@example.com : pass, @example.net : fail}".
+func parseDKIM(value string) []DKIMResult {
+	var results []DKIMResult
+
+	for _, entry := range strings.Split(strings.Trim(strings.TrimSpace(value), "{}"), ",") {
+		domain, result, ok := strings.Cut(entry, ":")
+		if !ok {
+			continue
+		}
+
+		results = append(results, DKIMResult{
+			Domain: strings.TrimPrefix(strings.TrimSpace(domain), "@"),
+			Result: strings.TrimSpace(result),
+		})
+	}
+
+	return results
+}
+
+// attachmentInfo describes an attachment posted in the default mode.
+type attachmentInfo struct {
+	Filename  string `json:"filename"`
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	ContentID string `json:"content-id"` //nolint:tagliatelle
+}
+
+// addAttachments completes the attachments posted in the default mode with their
+// description and content IDs.
+func (e *Email) addAttachments(values map[string]string, files []Attachment) error {
+	info := map[string]attachmentInfo{}
+	if err := unmarshalField(values, "attachment-info", &info); err != nil {
+		return err
+	}
+
+	// content-ids maps the content IDs to the fields of their attachment.
+	contentIDs := map[string]string{}
+	if err := unmarshalField(values, "content-ids", &contentIDs); err != nil {
+		return err
+	}
+
+	fieldContentIDs := map[string]string{}
+	for contentID, field := range contentIDs {
+		fieldContentIDs[field] = contentID
+	}
+
+	for _, file := range files {
+		if i, ok := info[file.Field]; ok {
+			if i.Filename != "" {
+				file.Filename = i.Filename
+			}
+
+			if i.Type != "" {
+				file.ContentType = i.Type
+			}
+
+			file.ContentID = i.ContentID
+		}
+
+		if contentID, ok := fieldContentIDs[file.Field]; ok {
+			file.ContentID = contentID
+		}
+
+		e.Attachments = append(e.Attachments, file)
+	}
+
+	return nil
+}
+
+// HandlerFunc handles an email received by the inbound parse webhook. SendGrid posts
+// the email again later when it returns an error.
+type HandlerFunc func(ctx context.Context, email *Email) error
+
+// Handler parses the posted emails and passes them to the function. It responds with
+// 400 Bad Request when an email can't be parsed, 413 Request Entity Too Large when it
+// exceeds a maximum size, and 500 Internal Server Error when the function fails.
+func (p *Parser) Handler(fn HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		email, err := p.Parse(r)
+		if err != nil {
+			status := http.StatusBadRequest
+			if errors.Is(err, ErrBodyTooLarge) || errors.Is(err, ErrAttachmentTooLarge) {
+				status = http.StatusRequestEntityTooLarge
+			}
+
+			http.Error(w, err.Error(), status)
+
+			return
+		}
+
+		if err := fn(r.Context(), email); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
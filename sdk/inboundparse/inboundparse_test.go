@@ -0,0 +1,244 @@
+package inboundparse_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/inboundparse"
+)
+
+// fixtureRequest returns a request posting a recorded fixture, the way SendGrid does.
+func fixtureRequest(t *testing.T, name string) *http.Request {
+	t.Helper()
+
+	body, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("failed reading the fixture: %v", err)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/inbound", bytes.NewReader(body))
+	r.Header.Set("Content-Type", "multipart/form-data; boundary=xYzZY")
+
+	return r
+}
+
+func TestParse_default(t *testing.T) {
+	t.Parallel()
+
+	email, err := inboundparse.New().Parse(fixtureRequest(t, "default.txt"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if email.From != "Example User <example@example.com>" || email.Subject != "Test #1" || email.Text != "Hello SendGrid!" {
+		t.Errorf("email = %q from %q: %q, want the posted fields", email.Subject, email.From, email.Text)
+	}
+
+	if !strings.Contains(email.HTML, "<strong>Hello SendGrid!</strong>") {
+		t.Errorf("html = %q", email.HTML)
+	}
+
+	want := inboundparse.Envelope{To: []string{"example@example.comom"}, From: "example@example.com"}
+	if !reflect.DeepEqual(email.Envelope, want) {
+		t.Errorf("envelope = %+v, want %+v", email.Envelope, want)
+	}
+
+	if email.SenderIP != "209.85.223.169" || email.SPF != "pass" {
+		t.Errorf("sender_ip = %q, SPF = %q", email.SenderIP, email.SPF)
+	}
+
+	wantDKIM := []inboundparse.DKIMResult{{Domain: "sendgrid.com", Result: "pass"}, {Domain: "example.net", Result: "fail"}}
+	if !reflect.DeepEqual(email.DKIM, wantDKIM) {
+		t.Errorf("dkim = %+v, want %+v", email.DKIM, wantDKIM)
+	}
+
+	if email.Charsets["text"] != "UTF-8" || len(email.Charsets) != 5 {
+		t.Errorf("charsets = %v", email.Charsets)
+	}
+
+	if email.SpamScore == nil || *email.SpamScore != 0.011 || !strings.Contains(email.SpamReport, "possible spam") {
+		t.Errorf("spam score = %v, report = %q", email.SpamScore, email.SpamReport)
+	}
+
+	if got := email.Headers.Get("Message-Id"); got != "<CAN_P_JMvV7ZpAQhOnDienypLrJmuhN=LQWweu4yScw4jQyXY2w@mail.gmail.com>" {
+		t.Errorf("Message-Id header = %q", got)
+	}
+
+	if email.Raw != nil {
+		t.Errorf("raw = %q, want nil in the default mode", email.Raw)
+	}
+
+	wantAttachments := []inboundparse.Attachment{
+		{Field: "attachment1", Filename: "notes.txt", ContentType: "text/plain", Content: []byte("Meeting at noon.")},
+		{
+			Field: "attachment2", Filename: "logo.png", ContentType: "image/png", ContentID: "ii_1562e2169c132d83",
+			Content: []byte("\u0089PNG fake image"),
+		},
+	}
+	if !reflect.DeepEqual(email.Attachments, wantAttachments) {
+		t.Errorf("attachments = %+v, want %+v", email.Attachments, wantAttachments)
+	}
+}
+
+func TestParse_raw(t *testing.T) {
+	t.Parallel()
+
+	email, err := inboundparse.New().Parse(fixtureRequest(t, "raw.txt"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if !bytes.HasPrefix(email.Raw, []byte("Received: ")) {
+		t.Errorf("raw = %q, want the MIME message", email.Raw)
+	}
+
+	if email.Subject != "Café order" || email.From != "Renée Example <renee@example.com>" {
+		t.Errorf("email = %q from %q", email.Subject, email.From)
+	}
+
+	if email.Headers.Get("Subject") != "=?UTF-8?Q?Caf=C3=A9_order?=" {
+		t.Errorf("Subject header = %q, want it as received", email.Headers.Get("Subject"))
+	}
+
+	if email.Text != "One café, please." || email.HTML != "<p>One caf&eacute;, please.</p>" {
+		t.Errorf("text = %q, html = %q", email.Text, email.HTML)
+	}
+
+	// The charsets posted by SendGrid are completed with the ones of the parts.
+	if email.Charsets["text"] != "UTF-8" || email.Charsets["html"] != "iso-8859-1" || email.Charsets["subject"] != "UTF-8" {
+		t.Errorf("charsets = %v", email.Charsets)
+	}
+
+	if email.DKIM != nil || email.SPF != "softfail" || email.SpamScore != nil {
+		t.Errorf("dkim = %v, SPF = %q, spam score = %v", email.DKIM, email.SPF, email.SpamScore)
+	}
+
+	wantAttachments := []inboundparse.Attachment{
+		{Filename: "order.pdf", ContentType: "application/pdf", Content: []byte("%PDF-1.4\n% order\n")},
+	}
+	if !reflect.DeepEqual(email.Attachments, wantAttachments) {
+		t.Errorf("attachments = %+v, want %+v", email.Attachments, wantAttachments)
+	}
+}
+
+func TestParse_errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		request func(t *testing.T) *http.Request
+		opts    []inboundparse.Option
+		wantErr error
+	}{
+		{
+			name: "not multipart",
+			request: func(*testing.T) *http.Request {
+				return httptest.NewRequest(http.MethodPost, "/inbound", strings.NewReader(`{"to":"a@example.com"}`))
+			},
+			wantErr: inboundparse.ErrNotMultipart,
+		},
+		{
+			name:    "attachment too large",
+			request: func(t *testing.T) *http.Request { return fixtureRequest(t, "default.txt") },
+			opts:    []inboundparse.Option{inboundparse.WithMaxAttachmentSize(8)},
+			wantErr: inboundparse.ErrAttachmentTooLarge,
+		},
+		{
+			name:    "raw attachment too large",
+			request: func(t *testing.T) *http.Request { return fixtureRequest(t, "raw.txt") },
+			opts:    []inboundparse.Option{inboundparse.WithMaxAttachmentSize(8)},
+			wantErr: inboundparse.ErrAttachmentTooLarge,
+		},
+		{
+			name:    "body too large",
+			request: func(t *testing.T) *http.Request { return fixtureRequest(t, "default.txt") },
+			opts:    []inboundparse.Option{inboundparse.WithMaxBodySize(1024)},
+			wantErr: inboundparse.ErrBodyTooLarge,
+		},
+		{
+			name: "invalid envelope",
+			request: func(*testing.T) *http.Request {
+				body := "--xYzZY\r\nContent-Disposition: form-data; name=\"envelope\"\r\n\r\nnot json\r\n--xYzZY--\r\n"
+				r := httptest.NewRequest(http.MethodPost, "/inbound", strings.NewReader(body))
+				r.Header.Set("Content-Type", "multipart/form-data; boundary=xYzZY")
+
+				return r
+			},
+			wantErr: inboundparse.ErrInvalidField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := inboundparse.New(tt.opts...).Parse(tt.request(t))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed storing the email")
+
+	tests := []struct {
+		name       string
+		fixture    string
+		opts       []inboundparse.Option
+		err        error
+		wantStatus int
+	}{
+		{name: "handled", fixture: "default.txt", wantStatus: http.StatusOK},
+		{name: "handler error", fixture: "raw.txt", err: errFailed, wantStatus: http.StatusInternalServerError},
+		{
+			name:       "too large",
+			fixture:    "default.txt",
+			opts:       []inboundparse.Option{inboundparse.WithMaxAttachmentSize(8)},
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var received *inboundparse.Email
+
+			handler := inboundparse.New(tt.opts...).Handler(func(_ context.Context, email *inboundparse.Email) error {
+				received = email
+
+				return tt.err
+			})
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, fixtureRequest(t, tt.fixture))
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body)
+			}
+
+			if tt.wantStatus != http.StatusRequestEntityTooLarge && received == nil {
+				t.Error("the handler didn't receive the email")
+			}
+		})
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/inbound", strings.NewReader("not a form"))
+	inboundparse.New().Handler(nil).ServeHTTP(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,140 @@
+package inboundparse
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+)
+
+// maxPartDepth is how deep multipart parts can be nested in a raw message.
+const maxPartDepth = 10
+
+// parseRaw parses the MIME message posted in the raw mode: its headers, text, HTML
+// and attachments.
+func (p *Parser) parseRaw(email *Email) error {
+	msg, err := mail.ReadMessage(bytes.NewReader(email.Raw))
+	if err != nil {
+		return fmt.Errorf("%w: email: %w", ErrInvalidField, err)
+	}
+
+	email.Headers = msg.Header
+
+	// SendGrid posts the main headers next to the message, decoded.
+	decoder := new(mime.WordDecoder)
+
+	for field, value := range map[string]*string{
+		"From":    &email.From,
+		"To":      &email.To,
+		"Cc":      &email.Cc,
+		"Subject": &email.Subject,
+	} {
+		if *value != "" {
+			continue
+		}
+
+		*value = msg.Header.Get(field)
+		if decoded, err := decoder.DecodeHeader(*value); err == nil {
+			*value = decoded
+		}
+	}
+
+	return p.parsePart(email, textproto.MIMEHeader(msg.Header), msg.Body, 0)
+}
+
+// parsePart parses a part of a raw message, and the parts nested in it.
+func (p *Parser) parsePart(email *Email, header textproto.MIMEHeader, body io.Reader, depth int) error {
+	if depth > maxPartDepth {
+		return fmt.Errorf("%w: email: parts nested more than %d times", ErrInvalidField, maxPartDepth)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+
+	switch strings.ToLower(header.Get("Content-Transfer-Encoding")) {
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		mr := multipart.NewReader(body, params["boundary"])
+
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF { //nolint:errorlint
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("%w: email: %w", ErrInvalidField, err)
+			}
+
+			if err := p.parsePart(email, part.Header, part, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	disposition, dispositionParams, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+
+	filename := dispositionParams["filename"]
+	if filename == "" {
+		filename = params["name"]
+	}
+
+	isText := mediaType == "text/plain" || mediaType == "text/html"
+	if disposition == "attachment" || filename != "" || !isText {
+		content, err := p.readAttachment(body, filename)
+		if err != nil {
+			return err
+		}
+
+		email.Attachments = append(email.Attachments, Attachment{
+			Filename:    filename,
+			ContentType: mediaType,
+			ContentID:   strings.Trim(header.Get("Content-Id"), "<>"),
+			Content:     content,
+		})
+
+		return nil
+	}
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return p.readError(err)
+	}
+
+	// The first text and HTML parts are the body, the others are alternatives.
+	field, value := "text", &email.Text
+	if mediaType == "text/html" {
+		field, value = "html", &email.HTML
+	}
+
+	if *value != "" {
+		return nil
+	}
+
+	*value = string(content)
+
+	if charset := params["charset"]; charset != "" {
+		if email.Charsets == nil {
+			email.Charsets = map[string]string{}
+		}
+
+		if _, ok := email.Charsets[field]; !ok {
+			email.Charsets[field] = charset
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// IPAddress is a dedicated IP address of the account.
+type IPAddress struct {
+	IP           string   `json:"ip"`
+	Pools        []string `json:"pools,omitempty"`
+	Subusers     []string `json:"subusers,omitempty"`
+	Warmup       bool     `json:"warmup"`
+	StartDate    int64    `json:"start_date,omitempty"` //nolint:tagliatelle
+	Whitelabeled bool     `json:"whitelabeled"`
+	RDNS         string   `json:"rdns,omitempty"`
+}
+
+// IPFilter narrows the dedicated IP addresses returned by ReadIPs.
+type IPFilter struct {
+	// Subuser only returns the IP addresses assigned to this subuser.
+	Subuser string
+	// ExcludeWhitelabels leaves out the IP addresses with a reverse DNS record.
+	ExcludeWhitelabels bool
+}
+
+func (f IPFilter) query() url.Values {
+	query := url.Values{}
+
+	if f.Subuser != "" {
+		query.Set("subuser", f.Subuser)
+	}
+
+	if f.ExcludeWhitelabels {
+		query.Set("exclude_whitelabels", strconv.FormatBool(f.ExcludeWhitelabels))
+	}
+
+	return query
+}
+
+// IPWarmup is a dedicated IP address in warmup: SendGrid limits the volume of email
+// sent from it, and raises the limit every day from StartDate.
+type IPWarmup struct {
+	IP        string `json:"ip"`
+	StartDate int64  `json:"start_date"` //nolint:tagliatelle
+}
+
+func parseIPAddress(resp *Response) (*IPAddress, RequestError) {
+	var body IPAddress
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing IP address: %w", err),
+			Response:   resp,
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
+
+// parseIPWarmup parses the warmup of an IP address, which SendGrid returns in a list.
+func parseIPWarmup(resp *Response) (*IPWarmup, RequestError) {
+	var body []IPWarmup
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing IP warmup: %w", err),
+			Response:   resp,
+		}
+	}
+
+	if len(body) == 0 {
+		return nil, RequestError{
+			StatusCode: http.StatusNotFound,
+			Err:        fmt.Errorf("IP address not in warmup: %w", ErrNotFound),
+			Response:   resp,
+		}
+	}
+
+	return &body[0], RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
+
+// ReadIPs retrieves all the dedicated IP addresses of the account matching the filter,
+// with their pools, subusers and warmup status.
+func (c *Client) ReadIPs(ctx context.Context, filter IPFilter) ([]IPAddress, RequestError) {
+	return readAllPages[IPAddress](ctx, c, "/ips", filter.query(), maxPageSize)
+}
+
+// ReadIP retrieves a dedicated IP address of the account.
+func (c *Client) ReadIP(ctx context.Context, ip string) (*IPAddress, RequestError) {
+	if ip == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPAddressRequired,
+		}
+	}
+
+	resp, err := c.Get(ctx, "GET", "/ips/"+url.PathEscape(ip))
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        err,
+			Response:   resp,
+		}
+	}
+
+	return parseIPAddress(resp)
+}
+
+// StartIPWarmup starts the warmup of a dedicated IP address.
+func (c *Client) StartIPWarmup(ctx context.Context, ip string) (*IPWarmup, RequestError) {
+	if ip == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPAddressRequired,
+		}
+	}
+
+	resp, err := c.Post(ctx, "POST", "/ips/warmup", IPWarmup{IP: ip})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedStartingIPWarmup, err),
+			Response:   resp,
+		}
+	}
+
+	return parseIPWarmup(resp)
+}
+
+// ReadIPWarmup retrieves the warmup of a dedicated IP address. It fails with
+// ErrNotFound once the warmup is over.
+func (c *Client) ReadIPWarmup(ctx context.Context, ip string) (*IPWarmup, RequestError) {
+	if ip == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPAddressRequired,
+		}
+	}
+
+	resp, err := c.Get(ctx, "GET", "/ips/warmup/"+url.PathEscape(ip))
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        err,
+			Response:   resp,
+		}
+	}
+
+	return parseIPWarmup(resp)
+}
+
+// StopIPWarmup stops the warmup of a dedicated IP address. The address sends at full
+// volume right away.
+func (c *Client) StopIPWarmup(ctx context.Context, ip string) (bool, RequestError) {
+	if ip == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPAddressRequired,
+		}
+	}
+
+	resp, err := c.Get(ctx, "DELETE", "/ips/warmup/"+url.PathEscape(ip))
+	if err != nil && resp.statusCode() != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedStoppingIPWarmup, err),
+			Response:   resp,
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
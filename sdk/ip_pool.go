@@ -0,0 +1,214 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// IPPool is a named group of dedicated IP addresses, used to send different types of email.
+type IPPool struct {
+	Name string   `json:"name,omitempty"`
+	IPs  []PoolIP `json:"ips,omitempty"`
+}
+
+// PoolIP is a dedicated IP address of an IPPool.
+type PoolIP struct {
+	IP        string `json:"ip"`
+	StartDate int64  `json:"start_date,omitempty"` //nolint:tagliatelle
+	Warmup    bool   `json:"warmup"`
+}
+
+// ipPoolResponse is an IP pool as returned by SendGrid: the listing and the creation
+// name it "name", the retrieval "pool_name".
+type ipPoolResponse struct {
+	Name     string   `json:"name"`
+	PoolName string   `json:"pool_name"` //nolint:tagliatelle
+	IPs      []PoolIP `json:"ips"`
+}
+
+func (r ipPoolResponse) ipPool() IPPool {
+	name := r.Name
+	if name == "" {
+		name = r.PoolName
+	}
+
+	return IPPool{Name: name, IPs: r.IPs}
+}
+
+func parseIPPool(resp *Response) (*IPPool, RequestError) {
+	var body ipPoolResponse
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing IP pool: %w", err),
+			Response:   resp,
+		}
+	}
+
+	pool := body.ipPool()
+
+	return &pool, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
+
+// ipPoolPath returns the path of an IP pool, whose name may contain spaces.
+func ipPoolPath(name string) string {
+	return "/ips/pools/" + url.PathEscape(name)
+}
+
+// CreateIPPool creates an IPPool and returns it.
+func (c *Client) CreateIPPool(ctx context.Context, name string) (*IPPool, RequestError) {
+	if name == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPPoolNameRequired,
+		}
+	}
+
+	resp, err := c.Post(ctx, "POST", "/ips/pools", IPPool{Name: name})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedCreatingIPPool, err),
+			Response:   resp,
+		}
+	}
+
+	return parseIPPool(resp)
+}
+
+// ReadIPPool retrieves an IPPool and the IP addresses in it.
+func (c *Client) ReadIPPool(ctx context.Context, name string) (*IPPool, RequestError) {
+	if name == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPPoolNameRequired,
+		}
+	}
+
+	resp, err := c.Get(ctx, "GET", ipPoolPath(name))
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        err,
+			Response:   resp,
+		}
+	}
+
+	return parseIPPool(resp)
+}
+
+// ReadIPPools retrieves all the IPPools, without their IP addresses, reading every page of the listing.
+func (c *Client) ReadIPPools(ctx context.Context) ([]IPPool, RequestError) {
+	body, err := readAllPages[ipPoolResponse](ctx, c, "/ips/pools", nil, maxPageSize)
+	if err.Err != nil {
+		return nil, err
+	}
+
+	pools := make([]IPPool, 0, len(body))
+	for _, p := range body {
+		pools = append(pools, p.ipPool())
+	}
+
+	return pools, err
+}
+
+// UpdateIPPool renames an IPPool and returns it.
+func (c *Client) UpdateIPPool(ctx context.Context, name, newName string) (*IPPool, RequestError) {
+	if name == "" || newName == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPPoolNameRequired,
+		}
+	}
+
+	resp, err := c.Post(ctx, "PUT", ipPoolPath(name), IPPool{Name: newName})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        err,
+			Response:   resp,
+		}
+	}
+
+	return parseIPPool(resp)
+}
+
+// DeleteIPPool deletes an IPPool. Its IP addresses are not deleted.
+func (c *Client) DeleteIPPool(ctx context.Context, name string) (bool, RequestError) {
+	if name == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPPoolNameRequired,
+		}
+	}
+
+	resp, err := c.Get(ctx, "DELETE", ipPoolPath(name))
+	if err != nil && resp.statusCode() != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedDeletingIPPool, err),
+			Response:   resp,
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
+
+// AddIPToPool adds a dedicated IP address to an IPPool, and returns the address with its pools.
+func (c *Client) AddIPToPool(ctx context.Context, name, ip string) (*IPAddress, RequestError) {
+	if name == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPPoolNameRequired,
+		}
+	}
+
+	if ip == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPAddressRequired,
+		}
+	}
+
+	resp, err := c.Post(ctx, "POST", ipPoolPath(name)+"/ips", PoolIP{IP: ip})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedAddingIPToPool, err),
+			Response:   resp,
+		}
+	}
+
+	return parseIPAddress(resp)
+}
+
+// RemoveIPFromPool removes a dedicated IP address from an IPPool.
+func (c *Client) RemoveIPFromPool(ctx context.Context, name, ip string) (bool, RequestError) {
+	if name == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPPoolNameRequired,
+		}
+	}
+
+	if ip == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPAddressRequired,
+		}
+	}
+
+	resp, err := c.Get(ctx, "DELETE", ipPoolPath(name)+"/ips/"+url.PathEscape(ip))
+	if err != nil && resp.statusCode() != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedRemovingIPFromPool, err),
+			Response:   resp,
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
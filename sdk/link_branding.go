@@ -3,6 +3,7 @@ package sendgrid
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -32,16 +33,17 @@ type LinkBranding struct {
 	DNS       LinkBrandingDNS `json:"dns,omitempty"`
 }
 
-func parseLinkBranding(respBody string) (*LinkBranding, RequestError) {
+func parseLinkBranding(resp *Response) (*LinkBranding, RequestError) {
 	var body LinkBranding
-	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed parsing API key: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
 // CreateLinkBranding creates an LinkBranding and returns it.
@@ -53,7 +55,7 @@ func (c *Client) CreateLinkBranding(ctx context.Context, domain string, subdomai
 		}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "POST", "/whitelabel/links", LinkBranding{
+	resp, err := c.Post(ctx, "POST", "/whitelabel/links", LinkBranding{
 		Domain:    domain,
 		Subdomain: subdomain,
 		IsDefault: isDefault,
@@ -62,17 +64,19 @@ func (c *Client) CreateLinkBranding(ctx context.Context, domain string, subdomai
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed creating API key: %w", err),
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices {
+	if resp.statusCode() >= http.StatusMultipleChoices {
 		return nil, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingLinkBranding, statusCode, respBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingLinkBranding, resp.statusCode(), resp.RawBody),
+			Response:   resp,
 		}
 	}
 
-	return parseLinkBranding(respBody)
+	return parseLinkBranding(resp)
 }
 
 // ReadLinkBranding retrieves an LinkBranding and returns it.
@@ -84,15 +88,16 @@ func (c *Client) ReadLinkBranding(ctx context.Context, id string) (*LinkBranding
 		}
 	}
 
-	respBody, _, err := c.Get(ctx, "GET", "/whitelabel/links/"+id)
+	resp, err := c.Get(ctx, "GET", "/whitelabel/links/"+id)
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: http.StatusInternalServerError,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseLinkBranding(respBody)
+	return parseLinkBranding(resp)
 }
 
 // UpdateLinkBranding edits an LinkBranding and returns it.
@@ -107,37 +112,41 @@ func (c *Client) UpdateLinkBranding(ctx context.Context, id string, isDefault bo
 	t := LinkBranding{}
 	t.IsDefault = isDefault
 
-	respBody, _, err := c.Post(ctx, "PATCH", "/whitelabel/links/"+id, t)
+	resp, err := c.Post(ctx, "PATCH", "/whitelabel/links/"+id, t)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseLinkBranding(respBody)
+	return parseLinkBranding(resp)
 }
 
-func (c *Client) ValidateLinkBranding(ctx context.Context, id string) RequestError {
+// ValidateLinkBranding asks SendGrid to check the DNS records of a LinkBranding,
+// and returns the result for each of them.
+func (c *Client) ValidateLinkBranding(ctx context.Context, id string) (*DNSValidation, RequestError) {
 	if id == "" {
-		return RequestError{
+		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        ErrLinkBrandingIDRequired,
 		}
 	}
 
-	_, statusCode, err := c.Post(ctx, "POST", "/whitelabel/links/"+id+"/validate", nil)
-	if err != nil || statusCode != 200 {
-		return RequestError{
-			StatusCode: statusCode,
+	resp, err := c.Post(ctx, "POST", "/whitelabel/links/"+id+"/validate", nil)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return RequestError{StatusCode: http.StatusOK, Err: nil}
+	return parseDNSValidation(resp)
 }
 
-// DeleteLinkBranding deletes an LinkBranding.
+// DeleteLinkBranding deletes an LinkBranding. A LinkBranding already deleted is not an error.
 func (c *Client) DeleteLinkBranding(ctx context.Context, id string) (bool, RequestError) {
 	if id == "" {
 		return false, RequestError{
@@ -146,20 +155,22 @@ func (c *Client) DeleteLinkBranding(ctx context.Context, id string) (bool, Reque
 		}
 	}
 
-	responseBody, statusCode, err := c.Get(ctx, "DELETE", "/whitelabel/links/"+id)
-	if err != nil {
+	resp, err := c.Get(ctx, "DELETE", "/whitelabel/links/"+id)
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		return false, RequestError{
-			StatusCode: http.StatusInternalServerError,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+	if resp.statusCode() >= http.StatusMultipleChoices && resp.statusCode() != http.StatusNotFound { // ignore not found
 		return false, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingLinkBranding, statusCode, responseBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingLinkBranding, resp.statusCode(), resp.RawBody),
+			Response:   resp,
 		}
 	}
 
-	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
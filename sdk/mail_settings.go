@@ -0,0 +1,172 @@
+package sendgrid
+
+import (
+	"context"
+	"net/http"
+)
+
+// MailSetting is the name of a mail setting, which applies to every email sent.
+type MailSetting string
+
+const (
+	// MailSettingFooter adds a footer to the emails.
+	MailSettingFooter MailSetting = "footer"
+	// MailSettingBCC sends a blind carbon copy of the emails to an address.
+	MailSettingBCC MailSetting = "bcc"
+	// MailSettingBouncePurge purges the bounces after a number of days.
+	MailSettingBouncePurge MailSetting = "bounce_purge"
+	// MailSettingForwardBounce forwards the bounce reports to an address.
+	MailSettingForwardBounce MailSetting = "forward_bounce"
+	// MailSettingForwardSpam forwards the spam reports to addresses.
+	MailSettingForwardSpam MailSetting = "forward_spam"
+	// MailSettingAddressAllowlist never suppresses the emails of some addresses or domains.
+	MailSettingAddressAllowlist MailSetting = "address_whitelist"
+	// MailSettingTemplate wraps the emails in a legacy HTML template.
+	MailSettingTemplate MailSetting = "template"
+)
+
+func (s MailSetting) path() string {
+	return "/mail_settings/" + string(s)
+}
+
+// MailSettingsFooter is the footer added to every email.
+type MailSettingsFooter struct {
+	Enabled      bool   `json:"enabled"`
+	HTMLContent  string `json:"html_content"`  //nolint:tagliatelle
+	PlainContent string `json:"plain_content"` //nolint:tagliatelle
+}
+
+// MailSettingsBCC is the address receiving a blind carbon copy of every email.
+type MailSettingsBCC struct {
+	Enabled bool   `json:"enabled"`
+	Email   string `json:"email"`
+}
+
+// MailSettingsBouncePurge is the number of days after which the bounces are purged.
+// A nil number keeps the bounces.
+type MailSettingsBouncePurge struct {
+	Enabled     bool `json:"enabled"`
+	SoftBounces *int `json:"soft_bounces"` //nolint:tagliatelle
+	HardBounces *int `json:"hard_bounces"` //nolint:tagliatelle
+}
+
+// MailSettingsForwardBounce is the address the bounce reports are forwarded to.
+type MailSettingsForwardBounce struct {
+	Enabled bool   `json:"enabled"`
+	Email   string `json:"email"`
+}
+
+// MailSettingsForwardSpam is the comma-separated list of addresses the spam reports are forwarded to.
+type MailSettingsForwardSpam struct {
+	Enabled bool   `json:"enabled"`
+	Email   string `json:"email"`
+}
+
+// MailSettingsAddressAllowlist is the list of addresses and domains whose emails are
+// never suppressed, e.g. after a bounce.
+type MailSettingsAddressAllowlist struct {
+	Enabled bool     `json:"enabled"`
+	List    []string `json:"list"`
+}
+
+// MailSettingsTemplate is the legacy HTML template wrapping every email.
+// Its content must contain the <% body %> tag.
+type MailSettingsTemplate struct {
+	Enabled     bool   `json:"enabled"`
+	HTMLContent string `json:"html_content"` //nolint:tagliatelle
+}
+
+// DisableMailSetting turns a mail setting off, leaving its other fields as is.
+func (c *Client) DisableMailSetting(ctx context.Context, setting MailSetting) (bool, RequestError) {
+	if _, err := patchSetting[settingEnabled](ctx, c, setting.path(), settingEnabled{Enabled: false}); err.Err != nil {
+		return false, err
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadMailSettingsFooter retrieves the footer mail setting.
+func (c *Client) ReadMailSettingsFooter(ctx context.Context) (*MailSettingsFooter, RequestError) {
+	return readSetting[MailSettingsFooter](ctx, c, MailSettingFooter.path())
+}
+
+// UpdateMailSettingsFooter changes the footer mail setting and returns it.
+func (c *Client) UpdateMailSettingsFooter(ctx context.Context, s MailSettingsFooter) (*MailSettingsFooter, RequestError) {
+	return patchSetting[MailSettingsFooter](ctx, c, MailSettingFooter.path(), s)
+}
+
+// ReadMailSettingsBCC retrieves the BCC mail setting.
+func (c *Client) ReadMailSettingsBCC(ctx context.Context) (*MailSettingsBCC, RequestError) {
+	return readSetting[MailSettingsBCC](ctx, c, MailSettingBCC.path())
+}
+
+// UpdateMailSettingsBCC changes the BCC mail setting and returns it.
+func (c *Client) UpdateMailSettingsBCC(ctx context.Context, s MailSettingsBCC) (*MailSettingsBCC, RequestError) {
+	return patchSetting[MailSettingsBCC](ctx, c, MailSettingBCC.path(), s)
+}
+
+// ReadMailSettingsBouncePurge retrieves the bounce purge mail setting.
+func (c *Client) ReadMailSettingsBouncePurge(ctx context.Context) (*MailSettingsBouncePurge, RequestError) {
+	return readSetting[MailSettingsBouncePurge](ctx, c, MailSettingBouncePurge.path())
+}
+
+// UpdateMailSettingsBouncePurge changes the bounce purge mail setting and returns it.
+func (c *Client) UpdateMailSettingsBouncePurge(
+	ctx context.Context,
+	s MailSettingsBouncePurge,
+) (*MailSettingsBouncePurge, RequestError) {
+	return patchSetting[MailSettingsBouncePurge](ctx, c, MailSettingBouncePurge.path(), s)
+}
+
+// ReadMailSettingsForwardBounce retrieves the forward bounce mail setting.
+func (c *Client) ReadMailSettingsForwardBounce(ctx context.Context) (*MailSettingsForwardBounce, RequestError) {
+	return readSetting[MailSettingsForwardBounce](ctx, c, MailSettingForwardBounce.path())
+}
+
+// UpdateMailSettingsForwardBounce changes the forward bounce mail setting and returns it.
+func (c *Client) UpdateMailSettingsForwardBounce(
+	ctx context.Context,
+	s MailSettingsForwardBounce,
+) (*MailSettingsForwardBounce, RequestError) {
+	return patchSetting[MailSettingsForwardBounce](ctx, c, MailSettingForwardBounce.path(), s)
+}
+
+// ReadMailSettingsForwardSpam retrieves the forward spam mail setting.
+func (c *Client) ReadMailSettingsForwardSpam(ctx context.Context) (*MailSettingsForwardSpam, RequestError) {
+	return readSetting[MailSettingsForwardSpam](ctx, c, MailSettingForwardSpam.path())
+}
+
+// UpdateMailSettingsForwardSpam changes the forward spam mail setting and returns it.
+func (c *Client) UpdateMailSettingsForwardSpam(
+	ctx context.Context,
+	s MailSettingsForwardSpam,
+) (*MailSettingsForwardSpam, RequestError) {
+	return patchSetting[MailSettingsForwardSpam](ctx, c, MailSettingForwardSpam.path(), s)
+}
+
+// ReadMailSettingsAddressAllowlist retrieves the address allowlist mail setting.
+func (c *Client) ReadMailSettingsAddressAllowlist(ctx context.Context) (*MailSettingsAddressAllowlist, RequestError) {
+	return readSetting[MailSettingsAddressAllowlist](ctx, c, MailSettingAddressAllowlist.path())
+}
+
+// UpdateMailSettingsAddressAllowlist changes the address allowlist mail setting and returns it.
+func (c *Client) UpdateMailSettingsAddressAllowlist(
+	ctx context.Context,
+	s MailSettingsAddressAllowlist,
+) (*MailSettingsAddressAllowlist, RequestError) {
+	if s.List == nil {
+		s.List = []string{}
+	}
+
+	return patchSetting[MailSettingsAddressAllowlist](ctx, c, MailSettingAddressAllowlist.path(), s)
+}
+
+// ReadMailSettingsTemplate retrieves the legacy template mail setting.
+func (c *Client) ReadMailSettingsTemplate(ctx context.Context) (*MailSettingsTemplate, RequestError) {
+	return readSetting[MailSettingsTemplate](ctx, c, MailSettingTemplate.path())
+}
+
+// UpdateMailSettingsTemplate changes the legacy template mail setting and returns it.
+func (c *Client) UpdateMailSettingsTemplate(ctx context.Context, s MailSettingsTemplate) (*MailSettingsTemplate, RequestError) {
+	return patchSetting[MailSettingsTemplate](ctx, c, MailSettingTemplate.path(), s)
+}
@@ -0,0 +1,66 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// maxPageSize is the largest page the SendGrid listings paginated with limit and offset return.
+const maxPageSize = 500
+
+// readAllPages reads every page of a listing paginated with the limit and offset
+// query parameters, and returns their items in order. The listing ends with the
+// first page holding less than pageSize items.
+func readAllPages[T any](ctx context.Context, c *Client, endpoint string, query url.Values, pageSize int) ([]T, RequestError) {
+	items, _, err := readPages[T](ctx, c, endpoint, query, pageSize, 0)
+
+	return items, err
+}
+
+// readPages reads the pages of a listing like readAllPages, but at most maxPages of them
+// when maxPages is positive. It returns whether the whole listing was read.
+func readPages[T any](
+	ctx context.Context, c *Client, endpoint string, query url.Values, pageSize, maxPages int,
+) ([]T, bool, RequestError) {
+	var items []T
+
+	for pages, offset := 0, 0; maxPages <= 0 || pages < maxPages; pages, offset = pages+1, offset+pageSize {
+		params := url.Values{}
+		for k, v := range query {
+			params[k] = v
+		}
+
+		params.Set("limit", strconv.Itoa(pageSize))
+		params.Set("offset", strconv.Itoa(offset))
+
+		resp, err := c.Get(ctx, "GET", endpoint+"?"+params.Encode())
+		if err != nil {
+			return nil, false, RequestError{
+				StatusCode: resp.statusCode(),
+				Err:        err,
+				Response:   resp,
+			}
+		}
+
+		var page []T
+		if err := json.Unmarshal([]byte(resp.RawBody), &page); err != nil {
+			return nil, false, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed parsing page at offset %d of %s: %w", offset, endpoint, err),
+				Response:   resp,
+			}
+		}
+
+		items = append(items, page...)
+
+		if len(page) < pageSize {
+			return items, true, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+		}
+	}
+
+	return items, false, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
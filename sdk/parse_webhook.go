@@ -3,6 +3,7 @@ package sendgrid
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -15,16 +16,17 @@ type ParseWebhook struct {
 	SendRaw   bool   `json:"send_raw"`   //nolint:tagliatelle
 }
 
-func parseParseWebhook(respBody string) (*ParseWebhook, RequestError) {
+func parseParseWebhook(resp *Response) (*ParseWebhook, RequestError) {
 	var body ParseWebhook
-	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed parsing inbound parse: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
 // CreateParseWebhook creates an ParseWebhook and returns it.
@@ -49,7 +51,7 @@ func (c *Client) CreateParseWebhook(
 		}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "POST", "/user/webhooks/parse/settings", ParseWebhook{
+	resp, err := c.Post(ctx, "POST", "/user/webhooks/parse/settings", ParseWebhook{
 		Hostname:  hostname,
 		URL:       url,
 		SpamCheck: spamCheck,
@@ -59,17 +61,19 @@ func (c *Client) CreateParseWebhook(
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed creating inbound parse: %w", err),
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices {
+	if resp.statusCode() >= http.StatusMultipleChoices {
 		return nil, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingParseWebhook, statusCode, respBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingParseWebhook, resp.statusCode(), resp.RawBody),
+			Response:   resp,
 		}
 	}
 
-	return parseParseWebhook(respBody)
+	return parseParseWebhook(resp)
 }
 
 // ReadParseWebhook retreives an ParseWebhook and returns it.
@@ -81,15 +85,16 @@ func (c *Client) ReadParseWebhook(ctx context.Context, hostname string) (*ParseW
 		}
 	}
 
-	respBody, _, err := c.Get(ctx, "GET", "/user/webhooks/parse/settings/"+hostname)
+	resp, err := c.Get(ctx, "GET", "/user/webhooks/parse/settings/"+hostname)
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: http.StatusInternalServerError,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseParseWebhook(respBody)
+	return parseParseWebhook(resp)
 }
 
 // UpdateParseWebhook edits an ParseWebhook and returns it.
@@ -105,7 +110,7 @@ func (c *Client) UpdateParseWebhook(ctx context.Context, hostname string, spamCh
 	t.SpamCheck = spamCheck
 	t.SendRaw = sendRaw
 
-	_, _, err := c.Post(ctx, "PUT", "/user/webhooks/parse/settings/"+hostname, t)
+	_, err := c.Post(ctx, "PUT", "/user/webhooks/parse/settings/"+hostname, t)
 	if err != nil {
 		return RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -118,7 +123,7 @@ func (c *Client) UpdateParseWebhook(ctx context.Context, hostname string, spamCh
 	}
 }
 
-// DeleteParseWebhook deletes an ParseWebhook.
+// DeleteParseWebhook deletes an ParseWebhook. A ParseWebhook already deleted is not an error.
 func (c *Client) DeleteParseWebhook(ctx context.Context, hostname string) (bool, RequestError) {
 	if hostname == "" {
 		return false, RequestError{
@@ -127,20 +132,22 @@ func (c *Client) DeleteParseWebhook(ctx context.Context, hostname string) (bool,
 		}
 	}
 
-	responseBody, statusCode, err := c.Get(ctx, "DELETE", "/user/webhooks/parse/settings/"+hostname)
-	if err != nil {
+	resp, err := c.Get(ctx, "DELETE", "/user/webhooks/parse/settings/"+hostname)
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		return false, RequestError{
-			StatusCode: http.StatusInternalServerError,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+	if resp.statusCode() >= http.StatusMultipleChoices && resp.statusCode() != http.StatusNotFound { // ignore not found
 		return false, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingParseWebhook, statusCode, responseBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingParseWebhook, resp.statusCode(), resp.RawBody),
+			Response:   resp,
 		}
 	}
 
-	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
@@ -0,0 +1,40 @@
+package sendgrid
+
+import (
+	"context"
+	"net/http"
+)
+
+const partnerSettingsNewRelicPath = "/partner_settings/new_relic"
+
+// PartnerSettingsNewRelic is the New Relic account the statistics of the emails are sent to.
+type PartnerSettingsNewRelic struct {
+	Enabled    bool   `json:"enabled"`
+	LicenseKey string `json:"license_key"` //nolint:tagliatelle
+	// EnableSubuserStatistics also sends the statistics of the subusers.
+	EnableSubuserStatistics bool `json:"enable_subuser_statistics"` //nolint:tagliatelle
+}
+
+// ReadPartnerSettingsNewRelic retrieves the New Relic partner setting.
+func (c *Client) ReadPartnerSettingsNewRelic(ctx context.Context) (*PartnerSettingsNewRelic, RequestError) {
+	return readSetting[PartnerSettingsNewRelic](ctx, c, partnerSettingsNewRelicPath)
+}
+
+// UpdatePartnerSettingsNewRelic changes the New Relic partner setting and returns it.
+func (c *Client) UpdatePartnerSettingsNewRelic(
+	ctx context.Context,
+	s PartnerSettingsNewRelic,
+) (*PartnerSettingsNewRelic, RequestError) {
+	return patchSetting[PartnerSettingsNewRelic](ctx, c, partnerSettingsNewRelicPath, s)
+}
+
+// DisablePartnerSettingsNewRelic stops sending the statistics to New Relic, leaving
+// the license key as is.
+func (c *Client) DisablePartnerSettingsNewRelic(ctx context.Context) (bool, RequestError) {
+	_, err := patchSetting[settingEnabled](ctx, c, partnerSettingsNewRelicPath, settingEnabled{Enabled: false})
+	if err.Err != nil {
+		return false, err
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
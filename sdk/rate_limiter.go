@@ -0,0 +1,170 @@
+package sendgrid
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultRateLimitBackoff is how long an endpoint family is paused after a 429
+// that came without any rate limit or Retry-After header.
+const defaultRateLimitBackoff = time.Second
+
+// umbrellaPrefixes are the first path segments that group unrelated endpoints,
+// each of them having its own rate limit on SendGrid's side.
+var umbrellaPrefixes = map[string]bool{ //nolint:gochecknoglobals
+	"user":              true,
+	"whitelabel":        true,
+	"sso":               true,
+	"asm":               true,
+	"suppression":       true,
+	"mail_settings":     true,
+	"tracking_settings": true,
+	"partner_settings":  true,
+	"ips":               true,
+}
+
+// RateLimiter throttles requests per endpoint family, using the
+// X-RateLimit-Remaining and X-RateLimit-Reset headers returned by SendGrid.
+// A single RateLimiter is safe for concurrent use and is meant to be shared
+// by every resource of a provider, so that parallel operations wait for the
+// rate limit window to reset instead of hitting HTTP 429.
+type RateLimiter struct {
+	mu      sync.Mutex
+	budgets map[string]*rateBudget
+}
+
+type rateBudget struct {
+	remaining int
+	reset     time.Time
+}
+
+// NewRateLimiter creates an empty RateLimiter.
+// The budget of an endpoint family is unknown until its first response.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		budgets: map[string]*rateBudget{},
+	}
+}
+
+// endpointFamily returns the rate limit bucket of an endpoint,
+// e.g. "templates" for "/templates/123/versions" or "user/webhooks" for "/user/webhooks/parse/settings".
+func endpointFamily(endpoint string) string {
+	path := strings.TrimPrefix(endpoint, "/")
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+
+	segments := strings.SplitN(path, "/", 3) //nolint:mnd
+	if len(segments) > 1 && umbrellaPrefixes[segments[0]] {
+		return segments[0] + "/" + segments[1]
+	}
+
+	return segments[0]
+}
+
+// wait blocks until the endpoint family has some budget left,
+// and reserves one request from it.
+func (l *RateLimiter) wait(ctx context.Context, family string) error {
+	if l == nil {
+		return nil
+	}
+
+	for {
+		delay := l.reserve(family)
+		if delay <= 0 {
+			return nil
+		}
+
+		tflog.Info(ctx, "SendGrid rate limit reached, waiting for the window to reset", map[string]interface{}{
+			"endpoint_family": family,
+			"wait":            delay.String(),
+		})
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes one request from the budget of the family,
+// or returns how long to wait before trying again.
+func (l *RateLimiter) reserve(family string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	budget, ok := l.budgets[family]
+	if !ok {
+		return 0
+	}
+
+	now := time.Now()
+	if !now.Before(budget.reset) {
+		// The window is over, the next response will tell us the new budget.
+		delete(l.budgets, family)
+
+		return 0
+	}
+
+	if budget.remaining > 0 {
+		budget.remaining--
+
+		return 0
+	}
+
+	return budget.reset.Sub(now)
+}
+
+// observe updates the budget of the family from the rate limit headers of a response.
+func (l *RateLimiter) observe(family string, resp *Response) {
+	if l == nil || resp == nil || resp.Response == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	rate := resp.Rate
+	budget, known := l.budgets[family]
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		reset := rate.Reset
+		if reset.IsZero() || !reset.After(time.Now()) {
+			reset = time.Now().Add(retryAfter(resp.Header))
+		}
+
+		l.budgets[family] = &rateBudget{remaining: 0, reset: reset}
+
+		return
+	}
+
+	if rate.Limit == 0 || rate.Reset.IsZero() {
+		return
+	}
+
+	// Responses of concurrent requests may come back out of order: within the same
+	// window, never give back budget that was already reserved by in-flight requests.
+	if known && budget.reset.Equal(rate.Reset) {
+		if rate.Remaining < budget.remaining {
+			budget.remaining = rate.Remaining
+		}
+
+		return
+	}
+
+	l.budgets[family] = &rateBudget{remaining: rate.Remaining, reset: rate.Reset}
+}
+
+// retryAfter reads the Retry-After header of a 429 response, in seconds.
+func retryAfter(header http.Header) time.Duration {
+	if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	return defaultRateLimitBackoff
+}
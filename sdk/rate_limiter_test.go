@@ -0,0 +1,200 @@
+package sendgrid_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+)
+
+// rateLimitedServer answers with the given budget per endpoint family, decreasing it on each call.
+func rateLimitedServer(t *testing.T, remaining int, reset time.Time, hits *int32) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(hits, 1)
+
+		mu.Lock()
+		left := remaining
+		if remaining > 0 {
+			remaining--
+		}
+		mu.Unlock()
+
+		w.Header().Set("X-RateLimit-Limit", "10")
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(left))
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		_, _ = w.Write([]byte(`{}`))
+	}))
+}
+
+func TestRateLimiter_waitsForReset(t *testing.T) {
+	t.Parallel()
+
+	var hits int32
+
+	reset := time.Now().Add(time.Second).Truncate(time.Second).Add(time.Second)
+	server := rateLimitedServer(t, 0, reset, &hits)
+	defer server.Close()
+
+	client := sendgrid.NewClient("secret", server.URL, "")
+
+	if _, err := client.Get(context.Background(), http.MethodGet, "/templates/1"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if _, err := client.Get(context.Background(), http.MethodGet, "/templates/2"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if time.Now().Before(reset) {
+		t.Errorf("second request was sent before the rate limit reset at %s", reset)
+	}
+}
+
+func TestRateLimiter_contextCanceledWhileWaiting(t *testing.T) {
+	t.Parallel()
+
+	var hits int32
+
+	server := rateLimitedServer(t, 0, time.Now().Add(time.Hour), &hits)
+	defer server.Close()
+
+	client := sendgrid.NewClient("secret", server.URL, "")
+
+	if _, err := client.Get(context.Background(), http.MethodGet, "/api_keys"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.Get(ctx, http.MethodGet, "/api_keys"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Get() error = %v, want context.DeadlineExceeded", err)
+	}
+
+	if got := atomic.LoadInt32(&hits); got != 1 {
+		t.Errorf("server got %d requests, want 1", got)
+	}
+}
+
+func TestRateLimiter_separateEndpointFamilies(t *testing.T) {
+	t.Parallel()
+
+	var hits int32
+
+	server := rateLimitedServer(t, 0, time.Now().Add(time.Hour), &hits)
+	defer server.Close()
+
+	client := sendgrid.NewClient("secret", server.URL, "")
+
+	if _, err := client.Get(context.Background(), http.MethodGet, "/user/webhooks/parse/settings"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	for _, endpoint := range []string{"/templates", "/user/scheduled_sends", "/teammates"} {
+		if _, err := client.Get(ctx, http.MethodGet, endpoint); err != nil {
+			t.Errorf("Get(%s) error = %v, should not be throttled by another family", endpoint, err)
+		}
+	}
+}
+
+func TestRateLimiter_throttlesConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	var hits int32
+
+	server := rateLimitedServer(t, 3, time.Now().Add(time.Hour), &hits)
+	defer server.Close()
+
+	client := sendgrid.NewClient("secret", server.URL, "")
+
+	// Learn the budget: 3 requests left, 2 once this one is counted.
+	if _, err := client.Get(context.Background(), http.MethodGet, "/teammates"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, _ = client.Get(ctx, http.MethodGet, "/teammates")
+		}()
+	}
+
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&hits); got != 4 {
+		t.Errorf("server got %d requests, want 4", got)
+	}
+}
+
+func TestRateLimiter_pausesAfterTooManyRequests(t *testing.T) {
+	t.Parallel()
+
+	var hits int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := sendgrid.NewClient("secret", server.URL, "", sendgrid.WithRetryPolicy(sendgrid.RetryPolicy{}))
+
+	if _, err := client.Get(context.Background(), http.MethodGet, "/scopes"); err == nil {
+		t.Fatal("Get() expected an error")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.Get(ctx, http.MethodGet, "/scopes"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Get() error = %v, want context.DeadlineExceeded", err)
+	}
+
+	if got := atomic.LoadInt32(&hits); got != 1 {
+		t.Errorf("server got %d requests, want 1", got)
+	}
+}
+
+func TestRateLimiter_disabled(t *testing.T) {
+	t.Parallel()
+
+	var hits int32
+
+	server := rateLimitedServer(t, 0, time.Now().Add(time.Hour), &hits)
+	defer server.Close()
+
+	client := sendgrid.NewClient("secret", server.URL, "", sendgrid.WithRateLimiter(nil))
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.Get(context.Background(), http.MethodGet, "/templates"); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+	}
+
+	if got := atomic.LoadInt32(&hits); got != 3 {
+		t.Errorf("server got %d requests, want 3", got)
+	}
+}
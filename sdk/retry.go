@@ -0,0 +1,162 @@
+package sendgrid
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxAttempts = 4
+	defaultBaseDelay   = time.Second
+	defaultMaxDelay    = 30 * time.Second
+	defaultJitter      = 0.2
+)
+
+// RetryPolicy describes how the Client retries requests that failed for a transient reason.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts of a request, the first one included.
+	// A value lower than 2 disables retries.
+	MaxAttempts int
+
+	// BaseDelay is the delay before the first retry, doubled on each subsequent retry.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between two attempts.
+	MaxDelay time.Duration
+
+	// Jitter is the fraction, between 0 and 1, of each delay that is randomized
+	// so that concurrent requests don't retry in lockstep.
+	Jitter float64
+
+	// RetryableStatusCodes are the HTTP status codes that trigger a retry.
+	RetryableStatusCodes []int
+
+	// RetryNetworkErrors enables retries when no response was received,
+	// e.g. on connection resets or timeouts.
+	RetryNetworkErrors bool
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used by NewClient:
+// up to 4 attempts on rate limiting, 5xx gateway errors, and network errors.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: defaultMaxAttempts,
+		BaseDelay:   defaultBaseDelay,
+		MaxDelay:    defaultMaxDelay,
+		Jitter:      defaultJitter,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryNetworkErrors: true,
+	}
+}
+
+// WithRetryPolicy overrides the RetryPolicy of the Client.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// shouldRetry tells whether a request can be sent again after the given outcome.
+// A POST is not idempotent: it is only retried when the API surely didn't process it,
+// i.e. when it was rate limited or when the connection couldn't even be established.
+func (p RetryPolicy) shouldRetry(method string, resp *Response, err error) bool {
+	if err != nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+		return false
+	}
+
+	if resp == nil {
+		if err == nil || !p.RetryNetworkErrors {
+			return false
+		}
+
+		return method != http.MethodPost || isDialError(err)
+	}
+
+	if !p.retryableStatusCode(resp.StatusCode) {
+		return false
+	}
+
+	return method != http.MethodPost || resp.StatusCode == http.StatusTooManyRequests
+}
+
+func (p RetryPolicy) retryableStatusCode(statusCode int) bool {
+	for _, code := range p.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// delay returns how long to wait before the given retry, starting at 1.
+func (p RetryPolicy) delay(retry int) time.Duration {
+	delay := float64(p.BaseDelay) * math.Pow(2, float64(retry-1)) //nolint:mnd
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		delay -= delay * jitter * rand.Float64() //nolint:gosec
+	}
+
+	return time.Duration(delay)
+}
+
+// isDialError tells whether the request failed before reaching the API.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+
+	return errors.As(err, &dnsErr)
+}
+
+// sleep waits for the given delay, or until the context is done.
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func logRetry(ctx context.Context, req *http.Request, attempt int, delay time.Duration, resp *Response, err error) {
+	fields := map[string]interface{}{
+		"method":  req.Method,
+		"path":    req.URL.Path,
+		"attempt": attempt,
+		"wait":    delay.String(),
+	}
+
+	if resp != nil {
+		fields["status_code"] = resp.StatusCode
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+
+	tflog.Warn(ctx, "Retrying SendGrid request after a transient failure", fields)
+}
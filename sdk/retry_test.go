@@ -0,0 +1,266 @@
+package sendgrid_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+)
+
+// dropConnection in a script makes the server close the connection without answering.
+const dropConnection = 0
+
+// scriptedServer answers each request with the next status code of the script,
+// and repeats the last one once the script is exhausted.
+type scriptedServer struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	script []int
+	bodies []string
+}
+
+func newScriptedServer(t *testing.T, script ...int) *scriptedServer {
+	t.Helper()
+
+	s := &scriptedServer{script: script}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		s.mu.Lock()
+		status := s.script[min(len(s.bodies), len(s.script)-1)]
+		s.bodies = append(s.bodies, string(body))
+		s.mu.Unlock()
+
+		if status == dropConnection {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Errorf("hijack: %v", err)
+
+				return
+			}
+
+			_ = conn.Close()
+
+			return
+		}
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *scriptedServer) requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.bodies...)
+}
+
+func testRetryPolicy() sendgrid.RetryPolicy {
+	policy := sendgrid.DefaultRetryPolicy()
+	policy.MaxAttempts = 3
+	policy.BaseDelay = time.Millisecond
+	policy.MaxDelay = 5 * time.Millisecond
+
+	return policy
+}
+
+func TestClient_retries(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		method       string
+		script       []int
+		policy       func(*sendgrid.RetryPolicy)
+		wantErr      bool
+		wantStatus   int
+		wantRequests int
+	}{
+		{
+			name:         "get recovers from server errors",
+			method:       http.MethodGet,
+			script:       []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantRequests: 3,
+		},
+		{
+			name:         "get gives up after max attempts",
+			method:       http.MethodGet,
+			script:       []int{http.StatusInternalServerError},
+			wantErr:      true,
+			wantStatus:   http.StatusInternalServerError,
+			wantRequests: 3,
+		},
+		{
+			name:         "client errors are not retried",
+			method:       http.MethodGet,
+			script:       []int{http.StatusBadRequest, http.StatusOK},
+			wantErr:      true,
+			wantStatus:   http.StatusBadRequest,
+			wantRequests: 1,
+		},
+		{
+			name:         "put recovers from a dropped connection",
+			method:       http.MethodPut,
+			script:       []int{dropConnection, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantRequests: 2,
+		},
+		{
+			name:         "delete recovers from rate limiting",
+			method:       http.MethodDelete,
+			script:       []int{http.StatusTooManyRequests, http.StatusNoContent},
+			wantStatus:   http.StatusNoContent,
+			wantRequests: 2,
+		},
+		{
+			name:         "post is not retried on server errors",
+			method:       http.MethodPost,
+			script:       []int{http.StatusServiceUnavailable, http.StatusCreated},
+			wantErr:      true,
+			wantStatus:   http.StatusServiceUnavailable,
+			wantRequests: 1,
+		},
+		{
+			name:         "post is not retried on a dropped connection",
+			method:       http.MethodPost,
+			script:       []int{dropConnection, http.StatusCreated},
+			wantErr:      true,
+			wantRequests: 1,
+		},
+		{
+			name:         "post is retried when rate limited",
+			method:       http.MethodPost,
+			script:       []int{http.StatusTooManyRequests, http.StatusCreated},
+			wantStatus:   http.StatusCreated,
+			wantRequests: 2,
+		},
+		{
+			name:   "network errors can be excluded",
+			method: http.MethodGet,
+			script: []int{dropConnection, http.StatusOK},
+			policy: func(p *sendgrid.RetryPolicy) {
+				p.RetryNetworkErrors = false
+			},
+			wantErr:      true,
+			wantRequests: 1,
+		},
+		{
+			name:   "status codes are configurable",
+			method: http.MethodGet,
+			script: []int{http.StatusConflict, http.StatusOK},
+			policy: func(p *sendgrid.RetryPolicy) {
+				p.RetryableStatusCodes = []int{http.StatusConflict}
+			},
+			wantStatus:   http.StatusOK,
+			wantRequests: 2,
+		},
+		{
+			name:   "retries can be disabled",
+			method: http.MethodGet,
+			script: []int{http.StatusServiceUnavailable, http.StatusOK},
+			policy: func(p *sendgrid.RetryPolicy) {
+				p.MaxAttempts = 1
+			},
+			wantErr:      true,
+			wantStatus:   http.StatusServiceUnavailable,
+			wantRequests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newScriptedServer(t, tt.script...)
+
+			policy := testRetryPolicy()
+			if tt.policy != nil {
+				tt.policy(&policy)
+			}
+
+			client := sendgrid.NewClient("secret", server.URL, "",
+				sendgrid.WithRetryPolicy(policy),
+				sendgrid.WithRateLimiter(nil),
+			)
+
+			var (
+				resp *sendgrid.Response
+				err  error
+			)
+
+			if tt.method == http.MethodGet || tt.method == http.MethodDelete {
+				resp, err = client.Get(context.Background(), tt.method, "/templates")
+			} else {
+				resp, err = client.Post(context.Background(), tt.method, "/templates", map[string]string{"name": "x"})
+			}
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			gotStatus := 0
+			if resp != nil {
+				gotStatus = resp.StatusCode
+			}
+
+			if gotStatus != tt.wantStatus {
+				t.Errorf("status = %d, want %d", gotStatus, tt.wantStatus)
+			}
+
+			if got := len(server.requests()); got != tt.wantRequests {
+				t.Errorf("server got %d requests, want %d", got, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestClient_retryResendsBody(t *testing.T) {
+	t.Parallel()
+
+	server := newScriptedServer(t, http.StatusBadGateway, http.StatusOK)
+	client := sendgrid.NewClient("secret", server.URL, "", sendgrid.WithRetryPolicy(testRetryPolicy()))
+
+	if _, err := client.Post(context.Background(), http.MethodPatch, "/templates/1", map[string]string{"name": "x"}); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+
+	requests := server.requests()
+	if len(requests) != 2 || requests[0] != `{"name":"x"}` || requests[1] != requests[0] {
+		t.Errorf("server got bodies %q, want the same body twice", requests)
+	}
+}
+
+func TestClient_retryStopsOnContextDone(t *testing.T) {
+	t.Parallel()
+
+	server := newScriptedServer(t, http.StatusServiceUnavailable)
+
+	policy := testRetryPolicy()
+	policy.MaxAttempts = 10
+	policy.BaseDelay = time.Hour
+	policy.MaxDelay = time.Hour
+
+	client := sendgrid.NewClient("secret", server.URL, "", sendgrid.WithRetryPolicy(policy))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.Get(ctx, http.MethodGet, "/templates"); err == nil {
+		t.Fatal("Get() expected an error")
+	}
+
+	if got := len(server.requests()); got != 1 {
+		t.Errorf("server got %d requests, want 1", got)
+	}
+}
@@ -0,0 +1,145 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ReverseDNSRecord is the A record to publish for a ReverseDNS.
+type ReverseDNSRecord struct {
+	Valid bool   `json:"valid,omitempty"`
+	Type  string `json:"type,omitempty"`
+	Host  string `json:"host,omitempty"`
+	Data  string `json:"data,omitempty"`
+}
+
+// ReverseDNSUser is a user sending from the IP address of a ReverseDNS.
+type ReverseDNSUser struct {
+	Username string `json:"username"`
+	UserID   int32  `json:"user_id"` //nolint:tagliatelle
+}
+
+// ReverseDNS is a Sendgrid reverse DNS, formerly IP whitelabel: the domain a dedicated
+// IP address resolves to.
+type ReverseDNS struct {
+	ID        int32            `json:"id,omitempty"`
+	IP        string           `json:"ip,omitempty"`
+	RDNS      string           `json:"rdns,omitempty"`
+	Users     []ReverseDNSUser `json:"users,omitempty"`
+	Subdomain string           `json:"subdomain,omitempty"`
+	Domain    string           `json:"domain,omitempty"`
+	Valid     bool             `json:"valid,omitempty"`
+	Legacy    bool             `json:"legacy,omitempty"`
+	ARecord   ReverseDNSRecord `json:"a_record,omitempty"` //nolint:tagliatelle
+}
+
+func parseReverseDNS(resp *Response) (*ReverseDNS, RequestError) {
+	var body ReverseDNS
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing reverse DNS: %w", err),
+			Response:   resp,
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
+
+// CreateReverseDNS creates the reverse DNS of a dedicated IP address and returns it.
+func (c *Client) CreateReverseDNS(ctx context.Context, ip, domain, subdomain string) (*ReverseDNS, RequestError) {
+	if ip == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrIPAddressRequired,
+		}
+	}
+
+	if domain == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrNameRequired,
+		}
+	}
+
+	resp, err := c.Post(ctx, "POST", "/whitelabel/ips", ReverseDNS{
+		IP:        ip,
+		Domain:    domain,
+		Subdomain: subdomain,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedCreatingReverseDNS, err),
+			Response:   resp,
+		}
+	}
+
+	return parseReverseDNS(resp)
+}
+
+// ReadReverseDNS retrieves a ReverseDNS and returns it.
+func (c *Client) ReadReverseDNS(ctx context.Context, id string) (*ReverseDNS, RequestError) {
+	if id == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrReverseDNSIDRequired,
+		}
+	}
+
+	resp, err := c.Get(ctx, "GET", "/whitelabel/ips/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        err,
+			Response:   resp,
+		}
+	}
+
+	return parseReverseDNS(resp)
+}
+
+// ValidateReverseDNS asks SendGrid to check the DNS records of a ReverseDNS, and returns
+// the result for each of them.
+func (c *Client) ValidateReverseDNS(ctx context.Context, id string) (*DNSValidation, RequestError) {
+	if id == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrReverseDNSIDRequired,
+		}
+	}
+
+	resp, err := c.Post(ctx, "POST", "/whitelabel/ips/"+id+"/validate", nil)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        err,
+			Response:   resp,
+		}
+	}
+
+	return parseDNSValidation(resp)
+}
+
+// DeleteReverseDNS deletes a ReverseDNS.
+func (c *Client) DeleteReverseDNS(ctx context.Context, id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrReverseDNSIDRequired,
+		}
+	}
+
+	resp, err := c.Get(ctx, "DELETE", "/whitelabel/ips/"+id)
+	if err != nil && resp.statusCode() != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedDeletingReverseDNS, err),
+			Response:   resp,
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
@@ -0,0 +1,227 @@
+package sendgridtest
+
+import (
+	"net/http"
+)
+
+// The event webhooks are stored in the /user/webhooks/event/settings collection, in
+// their order of creation. The legacy routes, without an ID, act on the oldest one as
+// SendGrid does.
+
+const eventWebhooks = "/user/webhooks/event/settings"
+
+// maxEventWebhooks is the number of event webhooks SendGrid allows per account.
+const maxEventWebhooks = 5
+
+// eventWebhookSecrets are the fields of an event webhook SendGrid never returns.
+var eventWebhookSecrets = []string{"oauth_client_secret"}
+
+func newEventWebhook() object {
+	return object{
+		"enabled": false, "url": "", "friendly_name": "", "group_resubscribe": false, "delivered": false,
+		"group_unsubscribe": false, "spam_report": false, "bounce": false, "deferred": false,
+		"unsubscribe": false, "processed": false, "open": false, "click": false, "dropped": false,
+		"oauth_client_id": "", "oauth_token_url": "", "public_key": "",
+	}
+}
+
+// oldestEventWebhook returns the event webhook the legacy routes act on.
+func (s *Server) oldestEventWebhook() (object, bool) {
+	list := s.collection(eventWebhooks).list(nil)
+	if len(list) == 0 {
+		return nil, false
+	}
+
+	return list[0], true
+}
+
+func (s *Server) createEventWebhook(w http.ResponseWriter, r *http.Request) {
+	o, err := decode(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+
+		return
+	}
+
+	if o.string("url") == "" {
+		writeError(w, http.StatusBadRequest, "url", "url is required")
+
+		return
+	}
+
+	c := s.collection(eventWebhooks)
+	if len(c.order) >= maxEventWebhooks {
+		writeError(w, http.StatusBadRequest, "", "the maximum number of event webhooks is reached")
+
+		return
+	}
+
+	webhook := newEventWebhook()
+	webhook.merge(o)
+	webhook["id"] = randomHex(16)
+	webhook["public_key"] = ""
+	webhook["created_date"] = now()
+
+	c.put(webhook.string("id"), webhook)
+	writeJSON(w, http.StatusCreated, webhook.without(eventWebhookSecrets...))
+}
+
+func (s *Server) listEventWebhooks(w http.ResponseWriter, _ *http.Request) {
+	list := s.collection(eventWebhooks).list(nil)
+	for i, webhook := range list {
+		list[i] = webhook.without(eventWebhookSecrets...)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"max_allowed": maxEventWebhooks, "webhooks": list})
+}
+
+func (s *Server) eventWebhook(w http.ResponseWriter, r *http.Request) (object, bool) {
+	webhook, ok := s.collection(eventWebhooks).get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w)
+	}
+
+	return webhook, ok
+}
+
+func (s *Server) getEventWebhook(w http.ResponseWriter, r *http.Request) {
+	if webhook, ok := s.eventWebhook(w, r); ok {
+		writeJSON(w, http.StatusOK, webhook.without(eventWebhookSecrets...))
+	}
+}
+
+// eventWebhookOAuthFields are the OAuth fields of an event webhook, which SendGrid
+// requires together.
+var eventWebhookOAuthFields = []string{"oauth_client_id", "oauth_client_secret", "oauth_token_url"}
+
+// patchEventWebhook updates an event webhook. Unlike the legacy route, SendGrid requires
+// the url and enabled fields, and the OAuth fields together.
+func (s *Server) patchEventWebhook(w http.ResponseWriter, r *http.Request) {
+	webhook, ok := s.eventWebhook(w, r)
+	if !ok {
+		return
+	}
+
+	patch, err := decode(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+
+		return
+	}
+
+	for _, field := range []string{"url", "enabled"} {
+		if _, ok := patch[field]; !ok {
+			writeError(w, http.StatusBadRequest, field, field+" is required")
+
+			return
+		}
+	}
+
+	oauth := 0
+
+	for _, field := range eventWebhookOAuthFields {
+		if _, ok := patch[field]; ok {
+			oauth++
+		}
+	}
+
+	if oauth > 0 && oauth < len(eventWebhookOAuthFields) {
+		writeError(w, http.StatusBadRequest, "oauth_client_id",
+			"oauth_client_id, oauth_client_secret and oauth_token_url must be sent together")
+
+		return
+	}
+
+	s.updateEventWebhook(w, webhook, patch)
+}
+
+func (s *Server) updateEventWebhook(w http.ResponseWriter, webhook, patch object) {
+	delete(patch, "id")
+	delete(patch, "public_key")
+	webhook.merge(patch)
+	writeJSON(w, http.StatusOK, webhook.without(eventWebhookSecrets...))
+}
+
+func (s *Server) getEventWebhookSigning(w http.ResponseWriter, r *http.Request) {
+	if webhook, ok := s.eventWebhook(w, r); ok {
+		writeJSON(w, http.StatusOK, object{"id": webhook["id"], "public_key": webhook["public_key"]})
+	}
+}
+
+func (s *Server) patchEventWebhookSigning(w http.ResponseWriter, r *http.Request) {
+	if webhook, ok := s.eventWebhook(w, r); ok {
+		s.signEventWebhook(w, r, webhook)
+	}
+}
+
+// signEventWebhook turns the signing of the webhook on or off. SendGrid generates a
+// new key pair every time the signing is turned on.
+func (s *Server) signEventWebhook(w http.ResponseWriter, r *http.Request, webhook object) {
+	patch, err := decode(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+
+		return
+	}
+
+	webhook["public_key"] = ""
+	if patch["enabled"] == true {
+		webhook["public_key"] = "MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE" + randomHex(32)
+	}
+
+	writeJSON(w, http.StatusOK, object{"id": webhook["id"], "public_key": webhook["public_key"]})
+}
+
+// getLegacyEventWebhook returns the oldest event webhook, or a disabled one when
+// there is none.
+func (s *Server) getLegacyEventWebhook(w http.ResponseWriter, _ *http.Request) {
+	webhook, ok := s.oldestEventWebhook()
+	if !ok {
+		webhook = newEventWebhook()
+	}
+
+	writeJSON(w, http.StatusOK, webhook.without(eventWebhookSecrets...))
+}
+
+// patchLegacyEventWebhook updates the oldest event webhook, or creates one when there
+// is none.
+func (s *Server) patchLegacyEventWebhook(w http.ResponseWriter, r *http.Request) {
+	webhook, ok := s.oldestEventWebhook()
+	if !ok {
+		webhook = newEventWebhook()
+		webhook["id"] = randomHex(16)
+		webhook["created_date"] = now()
+		s.collection(eventWebhooks).put(webhook.string("id"), webhook)
+	}
+
+	patch, err := decode(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+
+		return
+	}
+
+	s.updateEventWebhook(w, webhook, patch)
+}
+
+func (s *Server) getLegacyEventWebhookSigning(w http.ResponseWriter, _ *http.Request) {
+	webhook, ok := s.oldestEventWebhook()
+	if !ok {
+		writeJSON(w, http.StatusOK, object{"public_key": ""})
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"id": webhook["id"], "public_key": webhook["public_key"]})
+}
+
+func (s *Server) patchLegacyEventWebhookSigning(w http.ResponseWriter, r *http.Request) {
+	webhook, ok := s.oldestEventWebhook()
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	s.signEventWebhook(w, r, webhook)
+}
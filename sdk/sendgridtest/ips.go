@@ -0,0 +1,370 @@
+package sendgridtest
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// The dedicated IP addresses are stored in the /ips collection, with the names of their
+// pools in their "pools" field. The pools are stored in the /ips/pools collection.
+
+// AddIP adds a dedicated IP address to the account, as when SendGrid provisions it.
+func (s *Server) AddIP(ip string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.collection("/ips").put(ip, object{
+		"ip":           ip,
+		"pools":        []interface{}{},
+		"subusers":     []interface{}{},
+		"warmup":       false,
+		"start_date":   nil,
+		"whitelabeled": false,
+		"rdns":         "o1.ptr" + strconv.Itoa(s.nextID()) + ".sendgridtest.net",
+	})
+}
+
+// stringList returns the strings of a JSON array.
+func stringList(v interface{}) []string {
+	list, _ := v.([]interface{})
+
+	values := make([]string, 0, len(list))
+	for _, value := range list {
+		if value, ok := value.(string); ok {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// interfaceList returns a JSON array of strings.
+func interfaceList(values []string) []interface{} {
+	list := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		list = append(list, value)
+	}
+
+	return list
+}
+
+// poolNames returns the names of the pools of an IP address.
+func poolNames(ip object) []string {
+	return stringList(ip["pools"])
+}
+
+// setPoolNames replaces the pools of an IP address.
+func setPoolNames(ip object, names []string) {
+	ip["pools"] = interfaceList(names)
+}
+
+// assignIPs makes ips the IP addresses of a subuser, in the subusers of every IP address.
+func (s *Server) assignIPs(subuser string, ips []string) {
+	for _, ip := range s.collection("/ips").list(nil) {
+		subusers := slices.DeleteFunc(stringList(ip["subusers"]), func(u string) bool { return u == subuser })
+		if slices.Contains(ips, ip.string("ip")) {
+			subusers = append(subusers, subuser)
+		}
+
+		ip["subusers"] = interfaceList(subusers)
+	}
+}
+
+// renamePool replaces, or removes when newName is empty, a pool in the pools of every IP address.
+func (s *Server) renamePool(name, newName string) {
+	for _, ip := range s.collection("/ips").list(nil) {
+		var names []string
+
+		for _, n := range poolNames(ip) {
+			switch {
+			case n != name:
+				names = append(names, n)
+			case newName != "":
+				names = append(names, newName)
+			}
+		}
+
+		setPoolNames(ip, names)
+	}
+}
+
+func inPool(ip object, name string) bool {
+	for _, n := range poolNames(ip) {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Server) createIPPool(w http.ResponseWriter, r *http.Request) {
+	o, err := decode(r)
+	if err != nil || o.string("name") == "" {
+		writeError(w, http.StatusBadRequest, "name", "name is required")
+
+		return
+	}
+
+	pools := s.collection("/ips/pools")
+	if _, exists := pools.get(o.string("name")); exists {
+		writeError(w, http.StatusBadRequest, "name", "pool name already exists")
+
+		return
+	}
+
+	pool := object{"name": o.string("name")}
+	pools.put(pool.string("name"), pool)
+	writeJSON(w, http.StatusOK, pool)
+}
+
+// listIPPools lists the pools, paginated with the limit and offset query parameters.
+func (s *Server) listIPPools(w http.ResponseWriter, r *http.Request) {
+	pools := s.collection("/ips/pools").list(nil)
+
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	if err != nil || limit <= 0 || limit > 500 { //nolint:mnd
+		limit = 500
+	}
+
+	page := []object{}
+	if offset < len(pools) {
+		page = pools[offset:min(offset+limit, len(pools))]
+	}
+
+	writeJSON(w, http.StatusOK, page)
+}
+
+// ipPool returns the pool named in the path.
+func (s *Server) ipPool(w http.ResponseWriter, r *http.Request) (object, bool) {
+	pool, ok := s.collection("/ips/pools").get(r.PathValue("name"))
+	if !ok {
+		writeNotFound(w)
+	}
+
+	return pool, ok
+}
+
+func (s *Server) getIPPool(w http.ResponseWriter, r *http.Request) {
+	pool, ok := s.ipPool(w, r)
+	if !ok {
+		return
+	}
+
+	ips := []interface{}{}
+
+	for _, ip := range s.collection("/ips").list(func(ip object) bool { return inPool(ip, pool.string("name")) }) {
+		ips = append(ips, object{"ip": ip["ip"], "start_date": ip["start_date"], "warmup": ip["warmup"]})
+	}
+
+	writeJSON(w, http.StatusOK, object{"pool_name": pool["name"], "ips": ips})
+}
+
+func (s *Server) renameIPPool(w http.ResponseWriter, r *http.Request) {
+	pool, ok := s.ipPool(w, r)
+	if !ok {
+		return
+	}
+
+	o, err := decode(r)
+	if err != nil || o.string("name") == "" {
+		writeError(w, http.StatusBadRequest, "name", "name is required")
+
+		return
+	}
+
+	name, newName := pool.string("name"), o.string("name")
+	pools := s.collection("/ips/pools")
+
+	if _, exists := pools.get(newName); exists && newName != name {
+		writeError(w, http.StatusBadRequest, "name", "pool name already exists")
+
+		return
+	}
+
+	pools.delete(name)
+	pool["name"] = newName
+	pools.put(newName, pool)
+	s.renamePool(name, newName)
+
+	writeJSON(w, http.StatusOK, pool)
+}
+
+func (s *Server) deleteIPPool(w http.ResponseWriter, r *http.Request) {
+	pool, ok := s.ipPool(w, r)
+	if !ok {
+		return
+	}
+
+	s.collection("/ips/pools").delete(pool.string("name"))
+	s.renamePool(pool.string("name"), "")
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) addIPToPool(w http.ResponseWriter, r *http.Request) {
+	pool, ok := s.ipPool(w, r)
+	if !ok {
+		return
+	}
+
+	o, err := decode(r)
+	if err != nil || o.string("ip") == "" {
+		writeError(w, http.StatusBadRequest, "ip", "ip is required")
+
+		return
+	}
+
+	ip, ok := s.collection("/ips").get(o.string("ip"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "ip", "ip address not found")
+
+		return
+	}
+
+	if !inPool(ip, pool.string("name")) {
+		setPoolNames(ip, append(poolNames(ip), pool.string("name")))
+	}
+
+	writeJSON(w, http.StatusCreated, ip)
+}
+
+func (s *Server) removeIPFromPool(w http.ResponseWriter, r *http.Request) {
+	pool, ok := s.ipPool(w, r)
+	if !ok {
+		return
+	}
+
+	ip, ok := s.collection("/ips").get(r.PathValue("ip"))
+	if !ok || !inPool(ip, pool.string("name")) {
+		writeNotFound(w)
+
+		return
+	}
+
+	var names []string
+
+	for _, n := range poolNames(ip) {
+		if n != pool.string("name") {
+			names = append(names, n)
+		}
+	}
+
+	setPoolNames(ip, names)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listIPs lists the IP addresses, paginated with the limit and offset query parameters,
+// and filtered with the subuser and exclude_whitelabels ones.
+func (s *Server) listIPs(w http.ResponseWriter, r *http.Request) {
+	subuser := r.URL.Query().Get("subuser")
+	excludeWhitelabels := r.URL.Query().Get("exclude_whitelabels") == "true"
+
+	ips := s.collection("/ips").list(func(ip object) bool {
+		if excludeWhitelabels && ip["whitelabeled"] == true {
+			return false
+		}
+
+		return subuser == "" || slices.Contains(stringList(ip["subusers"]), subuser)
+	})
+
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	if err != nil || limit <= 0 || limit > 500 { //nolint:mnd
+		limit = 500
+	}
+
+	page := []object{}
+	if offset < len(ips) {
+		page = ips[offset:min(offset+limit, len(ips))]
+	}
+
+	writeJSON(w, http.StatusOK, page)
+}
+
+func (s *Server) getIP(w http.ResponseWriter, r *http.Request) {
+	ip, ok := s.collection("/ips").get(r.PathValue("ip"))
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ip)
+}
+
+// ipWarmup returns an IP address in warmup as SendGrid lists it.
+func ipWarmup(ip object) object {
+	return object{"ip": ip["ip"], "start_date": ip["start_date"]}
+}
+
+func (s *Server) startIPWarmup(w http.ResponseWriter, r *http.Request) {
+	o, err := decode(r)
+	if err != nil || o.string("ip") == "" {
+		writeError(w, http.StatusBadRequest, "ip", "ip is required")
+
+		return
+	}
+
+	ip, ok := s.collection("/ips").get(o.string("ip"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "ip", "ip address not found")
+
+		return
+	}
+
+	if ip["warmup"] == true {
+		writeError(w, http.StatusBadRequest, "ip", "ip address is already in warmup")
+
+		return
+	}
+
+	ip["warmup"] = true
+	ip["start_date"] = time.Now().Unix()
+
+	writeJSON(w, http.StatusOK, []object{ipWarmup(ip)})
+}
+
+func (s *Server) listIPWarmups(w http.ResponseWriter, _ *http.Request) {
+	warmups := []object{}
+	for _, ip := range s.collection("/ips").list(func(ip object) bool { return ip["warmup"] == true }) {
+		warmups = append(warmups, ipWarmup(ip))
+	}
+
+	writeJSON(w, http.StatusOK, warmups)
+}
+
+// warmingIP returns the IP address in warmup named in the path.
+func (s *Server) warmingIP(w http.ResponseWriter, r *http.Request) (object, bool) {
+	ip, ok := s.collection("/ips").get(r.PathValue("ip"))
+	if !ok || ip["warmup"] != true {
+		writeNotFound(w)
+
+		return nil, false
+	}
+
+	return ip, true
+}
+
+func (s *Server) getIPWarmup(w http.ResponseWriter, r *http.Request) {
+	if ip, ok := s.warmingIP(w, r); ok {
+		writeJSON(w, http.StatusOK, []object{ipWarmup(ip)})
+	}
+}
+
+func (s *Server) stopIPWarmup(w http.ResponseWriter, r *http.Request) {
+	ip, ok := s.warmingIP(w, r)
+	if !ok {
+		return
+	}
+
+	ip["warmup"] = false
+	ip["start_date"] = nil
+
+	w.WriteHeader(http.StatusNoContent)
+}
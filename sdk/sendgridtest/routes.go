@@ -0,0 +1,766 @@
+package sendgridtest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// resource describes a collection of objects served with the usual REST routes:
+// POST and GET on the collection, GET, PATCH, PUT and DELETE on its items.
+type resource struct {
+	// path is the path of the collection, e.g. /asm/groups.
+	path string
+
+	// idField is the field holding the ID of an object.
+	idField string
+
+	// newID returns the ID of a new object. Numeric IDs are used when nil.
+	newID func(o object) (interface{}, error)
+
+	// secrets are fields only returned when the object is created.
+	secrets []string
+
+	// wrapList returns the listing as {"result": [...]} instead of a plain array.
+	wrapList bool
+
+	// filter restricts the listing from the query string of the request.
+	filter func(r *http.Request, o object) bool
+
+	// create completes a new object before it is stored.
+	create func(o object)
+}
+
+func (s *Server) routes() {
+	s.handleResource(resource{path: "/api_keys", idField: "api_key_id", newID: randomID, secrets: []string{"api_key"},
+		create: func(o object) { o["api_key"] = "SG." + o.string("api_key_id") + "." + randomHex(16) }})
+	s.handleResource(resource{path: "/whitelabel/domains", idField: "id", create: s.newDomainAuthentication})
+	s.handleResource(resource{path: "/whitelabel/links", idField: "id", create: s.newLinkBranding})
+	s.handleResource(resource{path: "/whitelabel/ips", idField: "id", create: s.newReverseDNS})
+	s.handleResource(resource{path: "/user/webhooks/parse/settings", idField: "hostname", wrapList: true, newID: requiredField("hostname")})
+	s.handleResource(resource{path: "/sso/certificates", idField: "id", filter: queryFilter("integration_id")})
+	s.handleResource(resource{path: "/sso/integrations", idField: "id", newID: randomID, create: s.newSSOIntegration})
+	s.handleResource(resource{path: "/subusers", idField: "username", newID: requiredField("username"), secrets: []string{"password", "confirm_password"},
+		filter: queryFilter("username"), create: s.newSubUser})
+	s.handleResource(resource{path: "/templates", idField: "id", newID: templateID, wrapList: true,
+		filter: queryFilter("generations", "generation"), create: newTemplate})
+	s.handleResource(resource{path: "/asm/groups", idField: "id", create: func(o object) { o["unsubscribes"] = 0 }})
+
+	s.handle("POST /whitelabel/domains/{id}/validate", s.validate("/whitelabel/domains"))
+	s.handle("POST /whitelabel/links/{id}/validate", s.validate("/whitelabel/links"))
+	s.handle("POST /whitelabel/ips/{id}/validate", s.validate("/whitelabel/ips"))
+
+	s.handle("POST /user/webhooks/event/settings", s.createEventWebhook)
+	s.handle("GET /user/webhooks/event/settings/all", s.listEventWebhooks)
+	s.handle("GET /user/webhooks/event/settings/{id}", s.getEventWebhook)
+	s.handle("PATCH /user/webhooks/event/settings/{id}", s.patchEventWebhook)
+	s.handle("DELETE /user/webhooks/event/settings/{id}", s.deleteItem(eventWebhooks, "id"))
+	s.handle("GET /user/webhooks/event/settings/signed/{id}", s.getEventWebhookSigning)
+	s.handle("PATCH /user/webhooks/event/settings/signed/{id}", s.patchEventWebhookSigning)
+	s.handle("GET /user/webhooks/event/settings", s.getLegacyEventWebhook)
+	s.handle("PATCH /user/webhooks/event/settings", s.patchLegacyEventWebhook)
+	s.handle("GET /user/webhooks/event/settings/signed", s.getLegacyEventWebhookSigning)
+	s.handle("PATCH /user/webhooks/event/settings/signed", s.patchLegacyEventWebhookSigning)
+	s.handle("GET /mail_settings/{name}", s.getSetting("mail_settings"))
+	s.handle("PATCH /mail_settings/{name}", s.patchSetting("mail_settings"))
+	s.handle("GET /tracking_settings/{name}", s.getSetting("tracking_settings"))
+	s.handle("PATCH /tracking_settings/{name}", s.patchSetting("tracking_settings"))
+	s.handle("GET /user/settings/enforced_tls", s.getSingleton("user/settings/enforced_tls"))
+	s.handle("PATCH /user/settings/enforced_tls", s.patchSingleton("user/settings/enforced_tls"))
+	s.handle("GET /partner_settings/new_relic", s.getSingleton("partner_settings/new_relic"))
+	s.handle("PATCH /partner_settings/new_relic", s.patchSingleton("partner_settings/new_relic"))
+
+	s.handle("PUT /subusers/{username}/ips", s.putSubUserIPs)
+	s.handle("PUT /user/password", s.putPassword)
+
+	s.handle("POST /teammates", s.inviteTeammate)
+	s.handle("GET /teammates", s.listTeammates("teammates"))
+	s.handle("GET /teammates/pending", s.listTeammates("teammates/pending"))
+	s.handle("GET /teammates/{username}", s.getTeammate)
+	s.handle("PATCH /teammates/{username}", s.patchTeammate)
+	s.handle("DELETE /teammates/{username}", s.deleteItem("teammates", "username"))
+	s.handle("DELETE /teammates/pending/{token}", s.deleteItem("teammates/pending", "token"))
+	s.handle("POST /sso/teammates", s.createSSOTeammate)
+	s.handle("PATCH /sso/teammates/{username}", s.patchTeammate)
+
+	s.handle("POST /templates/{template_id}/versions", s.createTemplateVersion)
+	s.handle("GET /templates/{template_id}/versions/{id}", s.getTemplateVersion)
+	s.handle("PATCH /templates/{template_id}/versions/{id}", s.patchTemplateVersion)
+	s.handle("DELETE /templates/{template_id}/versions/{id}", s.deleteTemplateVersion)
+	s.handle("POST /templates/{template_id}/versions/{id}/activate", s.activateTemplateVersion)
+
+	s.handle("GET /ips", s.listIPs)
+	s.handle("GET /ips/{ip}", s.getIP)
+	s.handle("POST /ips/warmup", s.startIPWarmup)
+	s.handle("GET /ips/warmup", s.listIPWarmups)
+	s.handle("GET /ips/warmup/{ip}", s.getIPWarmup)
+	s.handle("DELETE /ips/warmup/{ip}", s.stopIPWarmup)
+	s.handle("POST /ips/pools", s.createIPPool)
+	s.handle("GET /ips/pools", s.listIPPools)
+	s.handle("GET /ips/pools/{name}", s.getIPPool)
+	s.handle("PUT /ips/pools/{name}", s.renameIPPool)
+	s.handle("DELETE /ips/pools/{name}", s.deleteIPPool)
+	s.handle("POST /ips/pools/{name}/ips", s.addIPToPool)
+	s.handle("DELETE /ips/pools/{name}/ips/{ip}", s.removeIPFromPool)
+
+	s.handle("POST /verified_senders", s.createVerifiedSender)
+	s.handle("GET /verified_senders", s.listVerifiedSenders)
+	s.handle("PATCH /verified_senders/{id}", s.patchVerifiedSender)
+	s.handle("DELETE /verified_senders/{id}", s.deleteItem("/verified_senders", "id"))
+	s.handle("POST /verified_senders/resend/{id}", s.resendSenderVerification)
+
+	s.handle("GET /asm/groups/{id}/suppressions", s.listGroupSuppressions)
+	s.handle("POST /asm/groups/{id}/suppressions", s.addGroupSuppressions)
+	s.handle("POST /asm/groups/{id}/suppressions/search", s.searchGroupSuppressions)
+	s.handle("DELETE /asm/groups/{id}/suppressions/{email}", s.deleteGroupSuppression)
+	s.handle("POST /asm/suppressions/global", s.addGlobalSuppressions)
+	s.handle("GET /asm/suppressions/global/{email}", s.getGlobalSuppression)
+	s.handle("DELETE /asm/suppressions/global/{email}", s.deleteGlobalSuppression)
+	s.handle("GET /suppression/unsubscribes", s.listGlobalSuppressions)
+	s.handle("GET /suppression/{list}", s.listSuppressionList)
+	s.handle("DELETE /suppression/{list}", s.deleteSuppressionListEntries)
+
+	s.handle("/", func(w http.ResponseWriter, _ *http.Request) { writeNotFound(w) })
+}
+
+// handle registers a handler that runs with the state of the server locked.
+func (s *Server) handle(pattern string, handler http.HandlerFunc) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		handler(w, r)
+	})
+}
+
+func (s *Server) handleResource(res resource) {
+	item := res.path + "/{id}"
+
+	s.handle("POST "+res.path, func(w http.ResponseWriter, r *http.Request) {
+		o, err := decode(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "", err.Error())
+
+			return
+		}
+
+		var id interface{} = s.nextID()
+		if res.newID != nil {
+			if id, err = res.newID(o); err != nil {
+				writeError(w, http.StatusBadRequest, res.idField, err.Error())
+
+				return
+			}
+		}
+
+		c := s.collection(res.path)
+		if _, exists := c.get(fmt.Sprint(id)); exists {
+			writeError(w, http.StatusBadRequest, res.idField, fmt.Sprintf("%v already exists", id))
+
+			return
+		}
+
+		o[res.idField] = id
+		if res.create != nil {
+			res.create(o)
+		}
+
+		c.put(fmt.Sprint(id), o)
+		writeJSON(w, http.StatusCreated, o)
+	})
+
+	s.handle("GET "+res.path, func(w http.ResponseWriter, r *http.Request) {
+		list := s.collection(res.path).list(func(o object) bool {
+			return res.filter == nil || res.filter(r, o)
+		})
+
+		for i, o := range list {
+			list[i] = o.without(res.secrets...)
+		}
+
+		if res.wrapList {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"result": list})
+
+			return
+		}
+
+		writeJSON(w, http.StatusOK, list)
+	})
+
+	s.handle("GET "+item, func(w http.ResponseWriter, r *http.Request) {
+		o, ok := s.collection(res.path).get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w)
+
+			return
+		}
+
+		writeJSON(w, http.StatusOK, o.without(res.secrets...))
+	})
+
+	update := func(w http.ResponseWriter, r *http.Request) {
+		o, ok := s.collection(res.path).get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w)
+
+			return
+		}
+
+		patch, err := decode(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "", err.Error())
+
+			return
+		}
+
+		delete(patch, res.idField)
+		o.merge(patch)
+		writeJSON(w, http.StatusOK, o.without(res.secrets...))
+	}
+
+	s.handle("PATCH "+item, update)
+	s.handle("PUT "+item, update)
+	s.handle("DELETE "+item, s.deleteItem(res.path, "id"))
+}
+
+// deleteItem deletes the object of the collection whose ID is the given path value.
+func (s *Server) deleteItem(collection, pathValue string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !s.collection(collection).delete(r.PathValue(pathValue)) {
+			writeNotFound(w)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+func randomID(object) (interface{}, error) {
+	return randomHex(11), nil
+}
+
+// requiredField uses a field of the request as the ID of the new object.
+func requiredField(field string) func(o object) (interface{}, error) {
+	return func(o object) (interface{}, error) {
+		id := o.string(field)
+		if id == "" {
+			return nil, fmt.Errorf("%s is required", field)
+		}
+
+		return id, nil
+	}
+}
+
+// queryFilter keeps the objects whose field equals the query parameter, when it is set.
+// The field defaults to the name of the query parameter.
+func queryFilter(param string, field ...string) func(r *http.Request, o object) bool {
+	name := param
+	if len(field) > 0 {
+		name = field[0]
+	}
+
+	return func(r *http.Request, o object) bool {
+		value := r.URL.Query().Get(param)
+
+		return value == "" || fmt.Sprint(o[name]) == value
+	}
+}
+
+func now() string {
+	return time.Now().UTC().Format("2006-01-02 15:04:05")
+}
+
+func (s *Server) newDomainAuthentication(o object) {
+	host := o.string("domain")
+	if sub := o.string("subdomain"); sub != "" {
+		host = sub + "." + host
+	}
+
+	o["valid"] = false
+	o["legacy"] = false
+	o["user_id"] = 1
+	o["username"] = "sendgridtest"
+	o["dns"] = map[string]interface{}{
+		"mail_cname": dnsRecord("cname", host, "u1.wl.sendgrid.net"),
+		"dkim1":      dnsRecord("cname", "s1._domainkey."+o.string("domain"), "s1.domainkey.u1.wl.sendgrid.net"),
+		"dkim2":      dnsRecord("cname", "s2._domainkey."+o.string("domain"), "s2.domainkey.u1.wl.sendgrid.net"),
+	}
+}
+
+func (s *Server) newLinkBranding(o object) {
+	host := o.string("domain")
+	if sub := o.string("subdomain"); sub != "" {
+		host = sub + "." + host
+	}
+
+	o["valid"] = false
+	o["legacy"] = false
+	o["user_id"] = 1
+	o["username"] = "sendgridtest"
+	o["dns"] = map[string]interface{}{
+		"domain_cname": dnsRecord("cname", host, "sendgrid.net"),
+		"owner_cname":  dnsRecord("cname", "1."+o.string("domain"), "sendgrid.net"),
+	}
+}
+
+func (s *Server) newReverseDNS(o object) {
+	rdns := o.string("domain")
+	if sub := o.string("subdomain"); sub != "" {
+		rdns = sub + "." + rdns
+	}
+
+	o["rdns"] = rdns
+	o["valid"] = false
+	o["legacy"] = false
+	o["users"] = []interface{}{map[string]interface{}{"username": "sendgridtest", "user_id": 1}}
+	o["a_record"] = dnsRecord("a", rdns, o.string("ip"))
+}
+
+func dnsRecord(kind, host, data string) map[string]interface{} {
+	return map[string]interface{}{"valid": false, "type": kind, "host": host, "data": data}
+}
+
+// validate checks the DNS records of the domain, link or reverse DNS: they are valid
+// unless their host was unpublished with UnpublishDNS.
+func (s *Server) validate(collection string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		o, ok := s.collection(collection).get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w)
+
+			return
+		}
+
+		records := map[string]interface{}{}
+		if record, ok := o["a_record"].(map[string]interface{}); ok {
+			records["a_record"] = record
+		}
+
+		if dns, ok := o["dns"].(map[string]interface{}); ok {
+			for name, record := range dns {
+				records[name] = record
+			}
+		}
+
+		valid := true
+		results := map[string]interface{}{}
+
+		for name, record := range records {
+			record, ok := record.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			host, _ := record["host"].(string)
+			if s.unpublished[host] {
+				valid = false
+				record["valid"] = false
+				results[name] = map[string]interface{}{
+					"valid":  false,
+					"reason": fmt.Sprintf("Expected %s record for %q to match %q, but none was found.", record["type"], host, record["data"]),
+				}
+
+				continue
+			}
+
+			record["valid"] = true
+			results[name] = map[string]interface{}{"valid": true, "reason": nil}
+		}
+
+		o["valid"] = valid
+		writeJSON(w, http.StatusOK, map[string]interface{}{"id": o["id"], "valid": valid, "validation_results": results})
+	}
+}
+
+// UnpublishDNS makes the validation of the DNS records of host fail, as if they
+// weren't published yet.
+func (s *Server) UnpublishDNS(host string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.unpublished[host] = true
+}
+
+// PublishDNS makes the validation of the DNS records of host succeed again.
+func (s *Server) PublishDNS(host string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.unpublished, host)
+}
+
+func (s *Server) newSSOIntegration(o object) {
+	id := o.string("id")
+	o["completed_integration"] = false
+	o["single_signon_url"] = "https://sso.sendgrid.com/saml2/acs/" + id
+	o["audience_url"] = "https://sso.sendgrid.com/saml2/metadata/" + id
+}
+
+func (s *Server) newSubUser(o object) {
+	o["id"] = s.nextID()
+	o["user_id"] = o["id"]
+	o["disabled"] = false
+	o["signup_session_token"] = randomHex(8)
+	o["authorization_token"] = randomHex(8)
+	o["credit_allocation"] = map[string]interface{}{"type": "unlimited"}
+	s.assignIPs(o.string("username"), stringList(o["ips"]))
+}
+
+func (s *Server) putSubUserIPs(w http.ResponseWriter, r *http.Request) {
+	o, ok := s.collection("/subusers").get(r.PathValue("username"))
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	var ips []string
+	if err := decodeInto(r, &ips); err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+
+		return
+	}
+
+	o["ips"] = ips
+	s.assignIPs(o.string("username"), ips)
+	writeJSON(w, http.StatusOK, ips)
+}
+
+// putPassword accepts the password change of the account, or of the subuser
+// it is made on behalf of.
+func (s *Server) putPassword(w http.ResponseWriter, r *http.Request) {
+	if subuser := r.Header.Get("On-Behalf-Of"); subuser != "" {
+		if _, ok := s.collection("/subusers").get(subuser); !ok {
+			writeError(w, http.StatusUnauthorized, "", "unknown subuser "+subuser)
+
+			return
+		}
+	}
+
+	o, err := decode(r)
+	if err != nil || o.string("new_password") == "" {
+		writeError(w, http.StatusBadRequest, "new_password", "new_password is required")
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) getSingleton(name string) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, s.singletons[name])
+	}
+}
+
+func (s *Server) patchSingleton(name string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		patch, err := decode(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "", err.Error())
+
+			return
+		}
+
+		s.singletons[name].merge(patch)
+		writeJSON(w, http.StatusOK, s.singletons[name])
+	}
+}
+
+// AcceptInvite turns the pending invitation of the email into an active teammate,
+// as when the invitee accepts it.
+func (s *Server) AcceptInvite(email string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pending := s.collection("teammates/pending")
+
+	for _, invite := range pending.list(nil) {
+		if invite.string("email") != email {
+			continue
+		}
+
+		pending.delete(invite.string("token"))
+
+		username := strings.SplitN(email, "@", 2)[0] //nolint:mnd
+		userType := "teammate"
+		if invite["is_admin"] == true {
+			userType = "admin"
+		}
+
+		s.collection("teammates").put(username, object{
+			"username":  username,
+			"email":     email,
+			"is_admin":  invite["is_admin"] == true,
+			"user_type": userType,
+			"scopes":    invite["scopes"],
+		})
+
+		return nil
+	}
+
+	return fmt.Errorf("no pending invitation for %s", email)
+}
+
+func (s *Server) inviteTeammate(w http.ResponseWriter, r *http.Request) {
+	o, err := decode(r)
+	if err != nil || o.string("email") == "" {
+		writeError(w, http.StatusBadRequest, "email", "email is required")
+
+		return
+	}
+
+	if s.teammateExists(o.string("email")) {
+		writeError(w, http.StatusBadRequest, "email", "teammate already exists")
+
+		return
+	}
+
+	token := randomHex(16)
+	invite := object{
+		"token":           token,
+		"email":           o.string("email"),
+		"is_admin":        o["is_admin"] == true,
+		"scopes":          o["scopes"],
+		"expiration_date": time.Now().Add(7 * 24 * time.Hour).Unix(), //nolint:mnd
+	}
+
+	s.collection("teammates/pending").put(token, invite)
+	writeJSON(w, http.StatusCreated, invite)
+}
+
+func (s *Server) createSSOTeammate(w http.ResponseWriter, r *http.Request) {
+	o, err := decode(r)
+	if err != nil || o.string("email") == "" {
+		writeError(w, http.StatusBadRequest, "email", "email is required")
+
+		return
+	}
+
+	if s.teammateExists(o.string("email")) {
+		writeError(w, http.StatusBadRequest, "email", "teammate already exists")
+
+		return
+	}
+
+	o["username"] = o.string("email")
+	o["is_sso"] = true
+	o["is_admin"] = o["is_admin"] == true
+	o["user_type"] = "teammate"
+
+	s.collection("teammates").put(o.string("username"), o)
+	writeJSON(w, http.StatusCreated, o)
+}
+
+func (s *Server) teammateExists(email string) bool {
+	same := func(o object) bool { return o.string("email") == email }
+
+	return len(s.collection("teammates").list(same)) > 0 || len(s.collection("teammates/pending").list(same)) > 0
+}
+
+func (s *Server) listTeammates(collection string) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"result": s.collection(collection).list(nil)})
+	}
+}
+
+func (s *Server) getTeammate(w http.ResponseWriter, r *http.Request) {
+	o, ok := s.collection("teammates").get(r.PathValue("username"))
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, o)
+}
+
+func (s *Server) patchTeammate(w http.ResponseWriter, r *http.Request) {
+	o, ok := s.collection("teammates").get(r.PathValue("username"))
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	patch, err := decode(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+
+		return
+	}
+
+	delete(patch, "username")
+	delete(patch, "email")
+	o.merge(patch)
+	writeJSON(w, http.StatusOK, o)
+}
+
+// templateID returns a dynamic template ID ("d-" prefixed), or a legacy template UUID.
+func templateID(o object) (interface{}, error) {
+	if o.string("name") == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+
+	if o.string("generation") == "legacy" {
+		h := randomHex(16)
+
+		return h[:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:], nil
+	}
+
+	return "d-" + randomHex(16), nil
+}
+
+func newTemplate(o object) {
+	if o.string("generation") == "" {
+		o["generation"] = "dynamic"
+	}
+
+	o["updated_at"] = now()
+	o["versions"] = []interface{}{}
+}
+
+func (s *Server) template(w http.ResponseWriter, r *http.Request) (object, bool) {
+	t, ok := s.collection("/templates").get(r.PathValue("template_id"))
+	if !ok {
+		writeNotFound(w)
+	}
+
+	return t, ok
+}
+
+// versions returns the versions of the template, stored within it as SendGrid returns them.
+func versions(t object) []interface{} {
+	v, _ := t["versions"].([]interface{})
+
+	return v
+}
+
+// templateVersion returns the version of the template named in the path, and its position.
+func (s *Server) templateVersion(w http.ResponseWriter, r *http.Request) (object, object, int) {
+	t, ok := s.template(w, r)
+	if !ok {
+		return nil, nil, -1
+	}
+
+	for i, v := range versions(t) {
+		if v, ok := v.(object); ok && v.string("id") == r.PathValue("id") {
+			return t, v, i
+		}
+	}
+
+	writeNotFound(w)
+
+	return nil, nil, -1
+}
+
+// activate makes the version the only active one of its template.
+func activate(t, version object) {
+	for _, v := range versions(t) {
+		if v, ok := v.(object); ok {
+			v["active"] = 0
+		}
+	}
+
+	version["active"] = 1
+}
+
+func (s *Server) createTemplateVersion(w http.ResponseWriter, r *http.Request) {
+	t, ok := s.template(w, r)
+	if !ok {
+		return
+	}
+
+	v, err := decode(r)
+	if err != nil || v.string("name") == "" {
+		writeError(w, http.StatusBadRequest, "name", "name is required")
+
+		return
+	}
+
+	h := randomHex(16)
+	v["id"] = h[:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+	v["template_id"] = t.string("id")
+	v["updated_at"] = now()
+
+	if v["editor"] == nil {
+		v["editor"] = "code"
+	}
+
+	t["versions"] = append(versions(t), v)
+
+	if active, _ := v["active"].(float64); active == 1 || len(versions(t)) == 1 {
+		activate(t, v)
+	} else {
+		v["active"] = 0
+	}
+
+	writeJSON(w, http.StatusCreated, v)
+}
+
+func (s *Server) getTemplateVersion(w http.ResponseWriter, r *http.Request) {
+	if _, v, i := s.templateVersion(w, r); i >= 0 {
+		writeJSON(w, http.StatusOK, v)
+	}
+}
+
+func (s *Server) patchTemplateVersion(w http.ResponseWriter, r *http.Request) {
+	t, v, i := s.templateVersion(w, r)
+	if i < 0 {
+		return
+	}
+
+	patch, err := decode(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+
+		return
+	}
+
+	delete(patch, "id")
+	delete(patch, "template_id")
+
+	active, _ := patch["active"].(float64)
+	delete(patch, "active")
+
+	v.merge(patch)
+	v["updated_at"] = now()
+
+	if active == 1 {
+		activate(t, v)
+	}
+
+	writeJSON(w, http.StatusOK, v)
+}
+
+func (s *Server) deleteTemplateVersion(w http.ResponseWriter, r *http.Request) {
+	t, _, i := s.templateVersion(w, r)
+	if i < 0 {
+		return
+	}
+
+	v := versions(t)
+	t["versions"] = append(v[:i:i], v[i+1:]...)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) activateTemplateVersion(w http.ResponseWriter, r *http.Request) {
+	t, v, i := s.templateVersion(w, r)
+	if i < 0 {
+		return
+	}
+
+	activate(t, v)
+	writeJSON(w, http.StatusOK, v)
+}
@@ -0,0 +1,355 @@
+// Package sendgridtest provides an in-process fake of the SendGrid API,
+// to test the SDK and the provider without a SendGrid account.
+//
+// The fake keeps the objects created through it in memory, so that a
+// create, read, update and delete cycle behaves like against SendGrid.
+// Faults (rate limiting, server errors, latency) can be injected to test
+// how the callers recover from them.
+package sendgridtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+)
+
+// APIKey is the API key accepted by the fake server.
+const APIKey = "SG.sendgridtest"
+
+// object is a JSON object stored by the fake server.
+type object map[string]interface{}
+
+// Request is a request received by the fake server.
+type Request struct {
+	Method string
+	Path   string
+	Query  string
+	Body   string
+}
+
+// Server is a fake SendGrid API listening on a local port.
+// Point the SDK client or the provider host at Server.URL.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	mux         *http.ServeMux
+	lastID      int
+	collections map[string]*collection
+	singletons  map[string]object
+	faults      []*Fault
+	requests    []Request
+	unpublished map[string]bool
+}
+
+// NewServer starts a fake SendGrid API. The caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		mux:         http.NewServeMux(),
+		collections: map[string]*collection{},
+		unpublished: map[string]bool{},
+		singletons:  defaultSettings(),
+	}
+
+	s.routes()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Client returns a SendGrid client authenticated against the server.
+func (s *Server) Client(opts ...sendgrid.ClientOption) *sendgrid.Client {
+	return sendgrid.NewClient(APIKey, s.URL, "", opts...)
+}
+
+// Requests returns the requests received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	// Accept both the host of the provider ("https://api.sendgrid.com/v3/") and a bare host.
+	r.URL.Path = strings.TrimPrefix(r.URL.Path, "/v3")
+
+	body, _ := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(strings.NewReader(string(body)))
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.RawQuery, Body: string(body)})
+	fault := s.matchFault(r)
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Request-Id", strconv.FormatInt(time.Now().UnixNano(), 36))
+
+	if r.Header.Get("Authorization") != "Bearer "+APIKey {
+		writeError(w, http.StatusUnauthorized, "", "authorization required")
+
+		return
+	}
+
+	if fault != nil && fault.apply(w, r) {
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// nextID returns a new unique numeric ID.
+func (s *Server) nextID() int {
+	s.lastID++
+
+	return s.lastID
+}
+
+func (s *Server) collection(name string) *collection {
+	c, ok := s.collections[name]
+	if !ok {
+		c = &collection{items: map[string]object{}}
+		s.collections[name] = c
+	}
+
+	return c
+}
+
+// collection is an ordered set of objects indexed by ID.
+type collection struct {
+	items map[string]object
+	order []string
+}
+
+func (c *collection) get(id string) (object, bool) {
+	o, ok := c.items[id]
+
+	return o, ok
+}
+
+func (c *collection) put(id string, o object) {
+	if _, ok := c.items[id]; !ok {
+		c.order = append(c.order, id)
+	}
+
+	c.items[id] = o
+}
+
+func (c *collection) delete(id string) bool {
+	if _, ok := c.items[id]; !ok {
+		return false
+	}
+
+	delete(c.items, id)
+
+	for i, v := range c.order {
+		if v == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+
+			break
+		}
+	}
+
+	return true
+}
+
+func (c *collection) list(keep func(object) bool) []object {
+	list := make([]object, 0, len(c.order))
+
+	for _, id := range c.order {
+		if keep == nil || keep(c.items[id]) {
+			list = append(list, c.items[id])
+		}
+	}
+
+	return list
+}
+
+// merge copies the fields of the patch into the object.
+func (o object) merge(patch object) {
+	for k, v := range patch {
+		o[k] = v
+	}
+}
+
+// without returns a copy of the object without the given fields, e.g. secrets
+// that SendGrid only returns once.
+func (o object) without(fields ...string) object {
+	c := make(object, len(o))
+	for k, v := range o {
+		c[k] = v
+	}
+
+	for _, f := range fields {
+		delete(c, f)
+	}
+
+	return c
+}
+
+func (o object) string(field string) string {
+	v, _ := o[field].(string)
+
+	return v
+}
+
+func decode(r *http.Request) (object, error) {
+	o := object{}
+	if err := decodeInto(r, &o); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+// decodeInto decodes the JSON body of the request, if any.
+func decodeInto(r *http.Request, v interface{}) error {
+	if r.ContentLength == 0 {
+		return nil
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return fmt.Errorf("invalid JSON body: %w", err)
+	}
+
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.WriteHeader(status)
+
+	if v != nil {
+		_ = json.NewEncoder(w).Encode(v)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, field, message string) {
+	detail := map[string]interface{}{"message": message, "field": nil}
+	if field != "" {
+		detail["field"] = field
+	}
+
+	writeJSON(w, status, map[string]interface{}{"errors": []interface{}{detail}})
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeError(w, http.StatusNotFound, "", "resource not found")
+}
+
+// Fault is an error or a delay injected in the responses of the fake server.
+type Fault struct {
+	// Method restricts the fault to a request method, e.g. POST. Empty matches any method.
+	Method string
+
+	// PathPrefix restricts the fault to the paths starting with it, e.g. /teammates. Empty matches any path.
+	PathPrefix string
+
+	// StatusCode is the HTTP status returned instead of the normal response. 0 only applies the latency.
+	StatusCode int
+
+	// Latency delays the response.
+	Latency time.Duration
+
+	// RetryAfter is the delay advertised by a 429 response. Defaults to 1 second.
+	RetryAfter time.Duration
+
+	// Count is the number of requests affected by the fault. 0 affects every request until ClearFaults.
+	Count int
+
+	hits int
+}
+
+// AddFault injects a fault in the next matching responses.
+func (s *Server) AddFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &f)
+}
+
+// RateLimitBurst makes the next n requests on paths starting with the prefix fail with HTTP 429.
+func (s *Server) RateLimitBurst(pathPrefix string, n int) {
+	s.AddFault(Fault{PathPrefix: pathPrefix, StatusCode: http.StatusTooManyRequests, Count: n})
+}
+
+// ServerErrors makes the next n requests on paths starting with the prefix fail with the given 5xx status.
+func (s *Server) ServerErrors(pathPrefix string, statusCode, n int) {
+	s.AddFault(Fault{PathPrefix: pathPrefix, StatusCode: statusCode, Count: n})
+}
+
+// ClearFaults removes all the injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+// matchFault returns the first active fault matching the request, and counts the hit.
+func (s *Server) matchFault(r *http.Request) *Fault {
+	for _, f := range s.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+
+		if !strings.HasPrefix(r.URL.Path, f.PathPrefix) {
+			continue
+		}
+
+		if f.Count > 0 && f.hits >= f.Count {
+			continue
+		}
+
+		f.hits++
+		fault := *f
+
+		return &fault
+	}
+
+	return nil
+}
+
+// apply delays the response and writes the error of the fault, if any.
+// It returns true when the response was written.
+func (f *Fault) apply(w http.ResponseWriter, r *http.Request) bool {
+	if f.Latency > 0 {
+		select {
+		case <-time.After(f.Latency):
+		case <-r.Context().Done():
+			return true
+		}
+	}
+
+	if f.StatusCode == 0 {
+		return false
+	}
+
+	if f.StatusCode == http.StatusTooManyRequests {
+		retryAfter := f.RetryAfter
+		if retryAfter <= 0 {
+			retryAfter = time.Second
+		}
+
+		seconds := int(math.Ceil(retryAfter.Seconds()))
+		w.Header().Set("Retry-After", strconv.Itoa(seconds))
+		w.Header().Set("X-RateLimit-Limit", "600")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(retryAfter).Unix(), 10))
+		writeError(w, f.StatusCode, "", "too many requests")
+
+		return true
+	}
+
+	writeError(w, f.StatusCode, "", http.StatusText(f.StatusCode))
+
+	return true
+}
@@ -0,0 +1,663 @@
+package sendgridtest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+)
+
+// fastRetries keeps the retries of the tests short.
+var fastRetries = sendgrid.WithRetryPolicy(sendgrid.RetryPolicy{ //nolint:gochecknoglobals
+	MaxAttempts:          4,
+	BaseDelay:            time.Millisecond,
+	MaxDelay:             10 * time.Millisecond,
+	RetryableStatusCodes: []int{http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusServiceUnavailable},
+})
+
+func TestServer_apiKeyLifecycle(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.Client()
+
+	created, err := client.CreateAPIKey(ctx, "ci", []string{"mail.send"})
+	if err.Err != nil {
+		t.Fatalf("CreateAPIKey() error = %v", err.Err)
+	}
+
+	if created.ID == "" || created.APIKey == "" {
+		t.Fatalf("CreateAPIKey() = %+v, want an ID and a secret", created)
+	}
+
+	read, err := client.ReadAPIKey(ctx, created.ID)
+	if err.Err != nil {
+		t.Fatalf("ReadAPIKey() error = %v", err.Err)
+	}
+
+	if read.APIKey != "" {
+		t.Errorf("ReadAPIKey() returned the secret %q, it is only returned on creation", read.APIKey)
+	}
+
+	if _, err = client.UpdateAPIKey(ctx, created.ID, "renamed", []string{"mail.send"}); err.Err != nil {
+		t.Fatalf("UpdateAPIKey() error = %v", err.Err)
+	}
+
+	if read, _ = client.ReadAPIKey(ctx, created.ID); read.Name != "renamed" {
+		t.Errorf("Name = %q, want %q", read.Name, "renamed")
+	}
+
+	if _, err = client.DeleteAPIKey(ctx, created.ID); err.Err != nil {
+		t.Fatalf("DeleteAPIKey() error = %v", err.Err)
+	}
+
+	if _, err = client.ReadAPIKey(ctx, created.ID); !errors.Is(err.Err, sendgrid.ErrNotFound) || err.StatusCode != http.StatusNotFound {
+		t.Errorf("ReadAPIKey() after delete = %+v, want %v", err, sendgrid.ErrNotFound)
+	}
+
+	if _, err = client.DeleteAPIKey(ctx, created.ID); err.Err != nil {
+		t.Errorf("DeleteAPIKey() of a deleted key error = %v, want none", err.Err)
+	}
+}
+
+func TestServer_teammateInvitation(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.Client()
+
+	if _, err := client.CreateUser(ctx, "jane@example.com", []string{"mail.send"}, false); err.Err != nil {
+		t.Fatalf("CreateUser() error = %v", err.Err)
+	}
+
+	pending, err := client.ReadUser(ctx, "jane@example.com")
+	if err.Err != nil {
+		t.Fatalf("ReadUser() error = %v", err.Err)
+	}
+
+	if pending.UserType != "pending" {
+		t.Errorf("UserType = %q, want pending", pending.UserType)
+	}
+
+	if acceptErr := server.AcceptInvite("jane@example.com"); acceptErr != nil {
+		t.Fatalf("AcceptInvite() error = %v", acceptErr)
+	}
+
+	if _, err = client.UpdateUser(ctx, "jane@example.com", nil, true); err.Err != nil {
+		t.Fatalf("UpdateUser() error = %v", err.Err)
+	}
+
+	active, err := client.ReadUser(ctx, "jane@example.com")
+	if err.Err != nil {
+		t.Fatalf("ReadUser() error = %v", err.Err)
+	}
+
+	if active.Username != "jane" || !active.IsAdmin {
+		t.Errorf("ReadUser() = %+v, want the active admin jane", active)
+	}
+
+	if _, err = client.DeleteUser(ctx, "jane@example.com"); err.Err != nil {
+		t.Fatalf("DeleteUser() error = %v", err.Err)
+	}
+
+	if _, err = client.ReadUser(ctx, "jane@example.com"); !errors.Is(err.Err, sendgrid.ErrNotFound) {
+		t.Errorf("ReadUser() after delete error = %v, want %v", err.Err, sendgrid.ErrNotFound)
+	}
+}
+
+func TestServer_templateVersions(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.Client()
+
+	template, err := client.CreateTemplate(ctx, "welcome", "dynamic")
+	if err.Err != nil {
+		t.Fatalf("CreateTemplate() error = %v", err.Err)
+	}
+
+	first, err := client.CreateTemplateVersion(ctx, sendgrid.TemplateVersion{TemplateID: template.ID, Name: "v1", Subject: "Hi"})
+	if err.Err != nil {
+		t.Fatalf("CreateTemplateVersion() error = %v", err.Err)
+	}
+
+	second, err := client.CreateTemplateVersion(ctx, sendgrid.TemplateVersion{TemplateID: template.ID, Name: "v2", Subject: "Hello"})
+	if err.Err != nil {
+		t.Fatalf("CreateTemplateVersion() error = %v", err.Err)
+	}
+
+	if _, err = client.ActivateTemplateVersion(ctx, *second); err.Err != nil {
+		t.Fatalf("ActivateTemplateVersion() error = %v", err.Err)
+	}
+
+	read, err := client.ReadTemplate(ctx, template.ID)
+	if err.Err != nil {
+		t.Fatalf("ReadTemplate() error = %v", err.Err)
+	}
+
+	active := map[string]int{}
+	for _, v := range read.Versions {
+		active[v.ID] = v.Active
+	}
+
+	if active[first.ID] != 0 || active[second.ID] != 1 {
+		t.Errorf("active versions = %v, want only %s", active, second.ID)
+	}
+
+	templates, err := client.ReadTemplates(ctx, "legacy")
+	if err.Err != nil {
+		t.Fatalf("ReadTemplates() error = %v", err.Err)
+	}
+
+	if len(templates) != 0 {
+		t.Errorf("ReadTemplates(legacy) = %v, want none", templates)
+	}
+}
+
+func TestServer_unauthorized(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	client := sendgrid.NewClient("SG.wrong", server.URL, "")
+
+	if _, err := client.ReadUnsubscribeGroups(context.Background()); !errors.Is(err.Err, sendgrid.ErrUnauthorized) {
+		t.Errorf("ReadUnsubscribeGroups() error = %v, want %v", err.Err, sendgrid.ErrUnauthorized)
+	}
+}
+
+func TestServer_faults(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		inject   func(s *sendgridtest.Server)
+		wantErr  error
+		attempts int
+	}{
+		{
+			name:     "rate limit burst is retried",
+			inject:   func(s *sendgridtest.Server) { s.RateLimitBurst("/asm", 2) },
+			attempts: 3,
+		},
+		{
+			name:     "transient server errors are retried",
+			inject:   func(s *sendgridtest.Server) { s.ServerErrors("/asm", http.StatusServiceUnavailable, 1) },
+			attempts: 2,
+		},
+		{
+			name:     "persistent server errors fail",
+			inject:   func(s *sendgridtest.Server) { s.ServerErrors("/asm", http.StatusInternalServerError, 0) },
+			wantErr:  sendgrid.ErrServerError,
+			attempts: 4,
+		},
+		{
+			name:     "faults on other paths are ignored",
+			inject:   func(s *sendgridtest.Server) { s.ServerErrors("/templates", http.StatusInternalServerError, 0) },
+			attempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := sendgridtest.NewServer()
+			defer server.Close()
+
+			tt.inject(server)
+
+			_, err := server.Client(fastRetries, sendgrid.WithRateLimiter(nil)).ReadUnsubscribeGroups(context.Background())
+			if !errors.Is(err.Err, tt.wantErr) {
+				t.Errorf("ReadUnsubscribeGroups() error = %v, want %v", err.Err, tt.wantErr)
+			}
+
+			if got := len(server.Requests()); got != tt.attempts {
+				t.Errorf("requests = %d, want %d", got, tt.attempts)
+			}
+		})
+	}
+}
+
+func TestServer_rateLimitHeaders(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	server.AddFault(sendgridtest.Fault{StatusCode: http.StatusTooManyRequests, RetryAfter: 3 * time.Second, Count: 1})
+
+	resp, err := server.Client(sendgrid.WithRetryPolicy(sendgrid.RetryPolicy{})).Get(context.Background(), http.MethodGet, "/asm/groups")
+	if !errors.Is(err, sendgrid.ErrRateLimited) {
+		t.Fatalf("Get() error = %v, want %v", err, sendgrid.ErrRateLimited)
+	}
+
+	if got := resp.Header.Get("Retry-After"); got != strconv.Itoa(3) {
+		t.Errorf("Retry-After = %q, want 3", got)
+	}
+
+	if resp.Rate.Remaining != 0 || resp.Rate.Reset.IsZero() {
+		t.Errorf("Rate = %+v, want an exhausted budget", resp.Rate)
+	}
+}
+
+func TestServer_latency(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	server.AddFault(sendgridtest.Fault{Latency: time.Minute})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := server.Client().ReadUnsubscribeGroups(ctx); !errors.Is(err.Err, context.DeadlineExceeded) {
+		t.Errorf("ReadUnsubscribeGroups() error = %v, want %v", err.Err, context.DeadlineExceeded)
+	}
+
+	server.ClearFaults()
+
+	if _, err := server.Client().ReadUnsubscribeGroups(context.Background()); err.Err != nil {
+		t.Errorf("ReadUnsubscribeGroups() after ClearFaults error = %v", err.Err)
+	}
+}
+
+func TestServer_ipPools(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	server.AddIP("192.0.2.10")
+	server.AddIP("192.0.2.11")
+
+	ctx := context.Background()
+	client := server.Client()
+
+	if _, err := client.CreateIPPool(ctx, "transactional mail"); err.Err != nil {
+		t.Fatalf("CreateIPPool() error = %v", err.Err)
+	}
+
+	if _, err := client.CreateIPPool(ctx, "transactional mail"); !errors.Is(err.Err, sendgrid.ErrBadRequest) {
+		t.Errorf("CreateIPPool() of an existing pool error = %v, want %v", err.Err, sendgrid.ErrBadRequest)
+	}
+
+	ip, err := client.AddIPToPool(ctx, "transactional mail", "192.0.2.10")
+	if err.Err != nil {
+		t.Fatalf("AddIPToPool() error = %v", err.Err)
+	}
+
+	if len(ip.Pools) != 1 || ip.Pools[0] != "transactional mail" {
+		t.Errorf("AddIPToPool() pools = %v, want [transactional mail]", ip.Pools)
+	}
+
+	if _, err = client.AddIPToPool(ctx, "transactional mail", "198.51.100.1"); !errors.Is(err.Err, sendgrid.ErrNotFound) {
+		t.Errorf("AddIPToPool() of an unknown IP error = %v, want %v", err.Err, sendgrid.ErrNotFound)
+	}
+
+	if _, err = client.UpdateIPPool(ctx, "transactional mail", "transactional"); err.Err != nil {
+		t.Fatalf("UpdateIPPool() error = %v", err.Err)
+	}
+
+	pool, err := client.ReadIPPool(ctx, "transactional")
+	if err.Err != nil {
+		t.Fatalf("ReadIPPool() error = %v", err.Err)
+	}
+
+	if pool.Name != "transactional" || len(pool.IPs) != 1 || pool.IPs[0].IP != "192.0.2.10" {
+		t.Errorf("ReadIPPool() after rename = %+v, want transactional with 192.0.2.10", pool)
+	}
+
+	if _, err = client.ReadIPPool(ctx, "transactional mail"); !errors.Is(err.Err, sendgrid.ErrNotFound) {
+		t.Errorf("ReadIPPool() of the former name error = %v, want %v", err.Err, sendgrid.ErrNotFound)
+	}
+
+	if _, err = client.RemoveIPFromPool(ctx, "transactional", "192.0.2.10"); err.Err != nil {
+		t.Fatalf("RemoveIPFromPool() error = %v", err.Err)
+	}
+
+	if _, err = client.RemoveIPFromPool(ctx, "transactional", "192.0.2.10"); err.Err != nil {
+		t.Errorf("RemoveIPFromPool() of a removed IP error = %v, want none", err.Err)
+	}
+
+	if _, err = client.DeleteIPPool(ctx, "transactional"); err.Err != nil {
+		t.Fatalf("DeleteIPPool() error = %v", err.Err)
+	}
+
+	pools, err := client.ReadIPPools(ctx)
+	if err.Err != nil {
+		t.Fatalf("ReadIPPools() error = %v", err.Err)
+	}
+
+	if len(pools) != 0 {
+		t.Errorf("ReadIPPools() = %v, want none", pools)
+	}
+}
+
+func TestServer_ipPoolsPagination(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+	ctx := context.Background()
+
+	const count = 501
+
+	for i := range count {
+		if _, err := client.CreateIPPool(ctx, fmt.Sprintf("pool-%03d", i)); err.Err != nil {
+			t.Fatalf("CreateIPPool() error = %v", err.Err)
+		}
+	}
+
+	pools, err := client.ReadIPPools(ctx)
+	if err.Err != nil {
+		t.Fatalf("ReadIPPools() error = %v", err.Err)
+	}
+
+	if len(pools) != count || pools[0].Name != "pool-000" || pools[count-1].Name != "pool-500" {
+		t.Errorf("ReadIPPools() returned %d pools, want %d in order", len(pools), count)
+	}
+
+	var pages []string
+
+	for _, r := range server.Requests() {
+		if r.Method == http.MethodGet {
+			pages = append(pages, r.Query)
+		}
+	}
+
+	if want := []string{"limit=500&offset=0", "limit=500&offset=500"}; !slices.Equal(pages, want) {
+		t.Errorf("requests = %v, want %v", pages, want)
+	}
+}
+
+func TestServer_ipsPagination(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	const count = 501
+
+	for i := range count {
+		server.AddIP(fmt.Sprintf("10.0.%d.%d", i/256, i%256))
+	}
+
+	ips, err := server.Client().ReadIPs(context.Background(), sendgrid.IPFilter{})
+	if err.Err != nil {
+		t.Fatalf("ReadIPs() error = %v", err.Err)
+	}
+
+	if len(ips) != count || ips[0].IP != "10.0.0.0" || ips[count-1].IP != "10.0.1.244" {
+		t.Errorf("ReadIPs() returned %d IPs, want %d in order", len(ips), count)
+	}
+
+	var pages []string
+
+	for _, r := range server.Requests() {
+		pages = append(pages, r.Query)
+	}
+
+	if want := []string{"limit=500&offset=0", "limit=500&offset=500"}; !slices.Equal(pages, want) {
+		t.Errorf("requests = %v, want %v", pages, want)
+	}
+}
+
+func TestServer_ipWarmup(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	server.AddIP("192.0.2.10")
+
+	ctx := context.Background()
+	client := server.Client()
+
+	warmup, err := client.StartIPWarmup(ctx, "192.0.2.10")
+	if err.Err != nil {
+		t.Fatalf("StartIPWarmup() error = %v", err.Err)
+	}
+
+	if warmup.IP != "192.0.2.10" || warmup.StartDate == 0 {
+		t.Errorf("StartIPWarmup() = %+v, want 192.0.2.10 with a start date", warmup)
+	}
+
+	if _, err = client.StartIPWarmup(ctx, "192.0.2.10"); !errors.Is(err.Err, sendgrid.ErrBadRequest) {
+		t.Errorf("StartIPWarmup() of an IP in warmup error = %v, want %v", err.Err, sendgrid.ErrBadRequest)
+	}
+
+	if _, err = client.ReadIPWarmup(ctx, "192.0.2.10"); err.Err != nil {
+		t.Fatalf("ReadIPWarmup() error = %v", err.Err)
+	}
+
+	ips, err := client.ReadIPs(ctx, sendgrid.IPFilter{})
+	if err.Err != nil {
+		t.Fatalf("ReadIPs() error = %v", err.Err)
+	}
+
+	if len(ips) != 1 || !ips[0].Warmup || ips[0].StartDate != warmup.StartDate {
+		t.Errorf("ReadIPs() = %+v, want the IP in warmup", ips)
+	}
+
+	if _, err = client.StopIPWarmup(ctx, "192.0.2.10"); err.Err != nil {
+		t.Fatalf("StopIPWarmup() error = %v", err.Err)
+	}
+
+	if _, err = client.StopIPWarmup(ctx, "192.0.2.10"); err.Err != nil {
+		t.Errorf("StopIPWarmup() of an IP out of warmup error = %v, want none", err.Err)
+	}
+
+	if _, err = client.ReadIPWarmup(ctx, "192.0.2.10"); !errors.Is(err.Err, sendgrid.ErrNotFound) {
+		t.Errorf("ReadIPWarmup() after stop error = %v, want %v", err.Err, sendgrid.ErrNotFound)
+	}
+}
+
+func TestServer_dnsValidation(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.Client()
+
+	link, err := client.CreateLinkBranding(ctx, "example.com", "url", false)
+	if err.Err != nil {
+		t.Fatalf("CreateLinkBranding() error = %v", err.Err)
+	}
+
+	id := strconv.Itoa(int(link.ID))
+
+	server.UnpublishDNS("url.example.com")
+
+	validation, err := client.ValidateLinkBranding(ctx, id)
+	if err.Err != nil {
+		t.Fatalf("ValidateLinkBranding() error = %v", err.Err)
+	}
+
+	if validation.Valid || !slices.Equal(validation.InvalidRecords(), []string{"domain_cname"}) {
+		t.Errorf("ValidateLinkBranding() = %+v, want domain_cname invalid", validation)
+	}
+
+	if validation.ValidationResults["domain_cname"].Reason == "" {
+		t.Errorf("ValidateLinkBranding() gave no reason for domain_cname")
+	}
+
+	server.PublishDNS("url.example.com")
+
+	if validation, err = client.ValidateLinkBranding(ctx, id); err.Err != nil || !validation.Valid {
+		t.Errorf("ValidateLinkBranding() after publishing = %+v, %v, want valid", validation, err.Err)
+	}
+}
+
+func TestServer_verifiedSendersPagination(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.Client()
+
+	const count = 101
+
+	for i := range count {
+		email := fmt.Sprintf("sender%d@example.com", i)
+
+		_, err := client.CreateVerifiedSender(ctx, sendgrid.VerifiedSender{
+			Nickname: email, FromEmail: email, ReplyTo: email, Address: "1 Example Street", City: "Denver", Country: "US",
+		})
+		if err.Err != nil {
+			t.Fatalf("CreateVerifiedSender() error = %v", err.Err)
+		}
+	}
+
+	senders, err := client.ReadVerifiedSenders(ctx)
+	if err.Err != nil {
+		t.Fatalf("ReadVerifiedSenders() error = %v", err.Err)
+	}
+
+	if len(senders) != count || senders[count-1].FromEmail != "sender100@example.com" {
+		t.Errorf("ReadVerifiedSenders() returned %d senders, want %d in order", len(senders), count)
+	}
+
+	last := senders[count-1]
+
+	sender, err := client.ReadVerifiedSender(ctx, strconv.Itoa(int(last.ID)))
+	if err.Err != nil || sender.FromEmail != last.FromEmail {
+		t.Errorf("ReadVerifiedSender() = %+v, %v, want %s", sender, err.Err, last.FromEmail)
+	}
+
+	if _, err = client.ReadVerifiedSender(ctx, "0"); !errors.Is(err.Err, sendgrid.ErrNotFound) {
+		t.Errorf("ReadVerifiedSender() of an unknown sender error = %v, want %v", err.Err, sendgrid.ErrNotFound)
+	}
+}
+
+func TestServer_suppressionBatches(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.Client()
+
+	group, err := client.CreateUnsubscribeGroup(ctx, "batches", "", false)
+	if err.Err != nil {
+		t.Fatalf("CreateUnsubscribeGroup() error = %v", err.Err)
+	}
+
+	groupID := strconv.Itoa(int(group.ID))
+
+	const count = 2500
+
+	emails := make([]string, 0, count)
+	for i := range count {
+		emails = append(emails, fmt.Sprintf("user%d@example.com", i))
+	}
+
+	if _, err = client.AddGroupSuppressions(ctx, groupID, emails); err.Err != nil {
+		t.Fatalf("AddGroupSuppressions() error = %v", err.Err)
+	}
+
+	posts := 0
+
+	for _, r := range server.Requests() {
+		if r.Method == http.MethodPost && r.Path == "/asm/groups/"+groupID+"/suppressions" {
+			posts++
+		}
+	}
+
+	if posts != 3 {
+		t.Errorf("AddGroupSuppressions() sent %d requests, want 3 batches", posts)
+	}
+
+	found, err := client.SearchGroupSuppressions(ctx, groupID, []string{"USER1@example.com", "user2499@example.com", "nobody@example.com"})
+	if err.Err != nil || len(found) != 2 {
+		t.Errorf("SearchGroupSuppressions() = %v, %v, want the 2 suppressed emails", found, err.Err)
+	}
+
+	if _, err = client.DeleteGroupSuppression(ctx, groupID, "user1@example.com"); err.Err != nil {
+		t.Errorf("DeleteGroupSuppression() error = %v", err.Err)
+	}
+
+	all, err := client.ReadGroupSuppressions(ctx, groupID)
+	if err.Err != nil || len(all) != count-1 {
+		t.Errorf("ReadGroupSuppressions() returned %d emails, %v, want %d", len(all), err.Err, count-1)
+	}
+
+	if _, err = client.ReadGroupSuppressions(ctx, "0"); !errors.Is(err.Err, sendgrid.ErrNotFound) {
+		t.Errorf("ReadGroupSuppressions() of an unknown group error = %v, want %v", err.Err, sendgrid.ErrNotFound)
+	}
+
+	suppressed, err := client.ReadGlobalSuppression(ctx, "user1@example.com")
+	if err.Err != nil || suppressed {
+		t.Errorf("ReadGlobalSuppression() = %t, %v, want false", suppressed, err.Err)
+	}
+}
+
+func TestServer_eventWebhookUpdate(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.Client()
+
+	url, enabled := "https://example.com/events", true
+	clientID, secret, tokenURL := "client", "secret", "https://oauth.example.com/token"
+
+	webhook, err := client.CreateEventWebhook(ctx, sendgrid.EventWebhookRequest{URL: &url, Enabled: &enabled})
+	if err.Err != nil {
+		t.Fatalf("CreateEventWebhook() error = %v", err.Err)
+	}
+
+	rejected := map[string]sendgrid.EventWebhookRequest{
+		"without url":          {Enabled: &enabled},
+		"without enabled":      {URL: &url},
+		"secret alone":         {URL: &url, Enabled: &enabled, OAuthClientSecret: &secret},
+		"token URL and ID":     {URL: &url, Enabled: &enabled, OAuthClientID: &clientID, OAuthTokenURL: &tokenURL},
+		"secret and client ID": {URL: &url, Enabled: &enabled, OAuthClientSecret: &secret, OAuthClientID: &clientID},
+	}
+
+	for name, request := range rejected {
+		if _, err := client.UpdateEventWebhook(ctx, webhook.ID, request); err.StatusCode != http.StatusBadRequest {
+			t.Errorf("%s: UpdateEventWebhook() status = %d, want %d", name, err.StatusCode, http.StatusBadRequest)
+		}
+	}
+
+	request := sendgrid.EventWebhookRequest{
+		URL: &url, Enabled: &enabled, OAuthClientID: &clientID, OAuthClientSecret: &secret, OAuthTokenURL: &tokenURL,
+	}
+
+	updated, err := client.UpdateEventWebhook(ctx, webhook.ID, request)
+	if err.Err != nil {
+		t.Fatalf("UpdateEventWebhook() error = %v", err.Err)
+	}
+
+	if updated.OAuthClientID != clientID || updated.OAuthTokenURL != tokenURL {
+		t.Errorf("UpdateEventWebhook() = %+v, want the OAuth settings", updated)
+	}
+}
@@ -0,0 +1,61 @@
+package sendgridtest
+
+import (
+	"net/http"
+)
+
+// The settings of the account are stored in the singletons of the server, named after
+// their path, e.g. "mail_settings/footer". SendGrid returns them whether they were
+// changed or not, so the server starts with their defaults.
+
+func defaultSettings() map[string]object {
+	return map[string]object{
+		"mail_settings/footer":            {"enabled": false, "html_content": "", "plain_content": ""},
+		"mail_settings/bcc":               {"enabled": false, "email": ""},
+		"mail_settings/bounce_purge":      {"enabled": false, "soft_bounces": nil, "hard_bounces": nil},
+		"mail_settings/forward_bounce":    {"enabled": false, "email": ""},
+		"mail_settings/forward_spam":      {"enabled": false, "email": ""},
+		"mail_settings/address_whitelist": {"enabled": false, "list": []interface{}{}},
+		"mail_settings/template":          {"enabled": false, "html_content": ""},
+
+		"tracking_settings/click": {"enabled": true, "enable_text": false},
+		"tracking_settings/open":  {"enabled": true},
+		"tracking_settings/subscription": {
+			"enabled": false, "html_content": "", "plain_content": "", "landing": "", "replace": "", "url": "",
+		},
+		"tracking_settings/google_analytics": {
+			"enabled": false, "utm_source": "", "utm_medium": "", "utm_term": "", "utm_content": "", "utm_campaign": "",
+		},
+
+		"user/settings/enforced_tls": {"require_tls": false, "require_valid_cert": false, "version": 1.1},
+		"partner_settings/new_relic": {"enabled": false, "license_key": "", "enable_subuser_statistics": false},
+	}
+}
+
+// setting returns the name of the setting of the path, or writes a not found error.
+func (s *Server) setting(w http.ResponseWriter, r *http.Request, prefix string) (string, bool) {
+	name := prefix + "/" + r.PathValue("name")
+	if _, ok := s.singletons[name]; !ok {
+		writeNotFound(w)
+
+		return "", false
+	}
+
+	return name, true
+}
+
+func (s *Server) getSetting(prefix string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if name, ok := s.setting(w, r, prefix); ok {
+			s.getSingleton(name)(w, r)
+		}
+	}
+}
+
+func (s *Server) patchSetting(prefix string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if name, ok := s.setting(w, r, prefix); ok {
+			s.patchSingleton(name)(w, r)
+		}
+	}
+}
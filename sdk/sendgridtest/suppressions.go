@@ -0,0 +1,294 @@
+package sendgridtest
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The suppressions of an unsubscribe group are stored in the /asm/groups/{id}/suppressions
+// collection, and the global suppressions in the /asm/suppressions/global collection. Both
+// are indexed by the lowercased email, as SendGrid matches emails case-insensitively.
+// The bounces, blocks, spam reports and invalid emails are stored in the
+// /suppression/{list} collections, indexed the same way.
+
+// maxRecipientEmails is the number of emails SendGrid accepts in a request adding or
+// searching suppressions.
+const maxRecipientEmails = 1000
+
+func groupSuppressions(groupID string) string {
+	return "/asm/groups/" + groupID + "/suppressions"
+}
+
+// Unsubscribe suppresses an email from an unsubscribe group, as when the recipient
+// clicks the unsubscribe link of an email.
+func (s *Server) Unsubscribe(groupID, email string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	group, ok := s.collection("/asm/groups").get(groupID)
+	if !ok {
+		return errors.New("no unsubscribe group " + groupID)
+	}
+
+	s.suppress(groupSuppressions(groupID), []string{email})
+	group["unsubscribes"] = len(s.collection(groupSuppressions(groupID)).order)
+
+	return nil
+}
+
+// suppress adds the emails to the suppressions, leaving the ones already suppressed as is.
+func (s *Server) suppress(collection string, emails []string) {
+	c := s.collection(collection)
+
+	for _, email := range emails {
+		if _, ok := c.get(strings.ToLower(email)); !ok {
+			c.put(strings.ToLower(email), object{"email": email})
+		}
+	}
+}
+
+// recipientEmails decodes the emails of a request adding or searching suppressions.
+func recipientEmails(w http.ResponseWriter, r *http.Request) ([]string, bool) {
+	o, err := decode(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+
+		return nil, false
+	}
+
+	emails := stringList(o["recipient_emails"])
+	if len(emails) == 0 || len(emails) > maxRecipientEmails {
+		writeError(w, http.StatusBadRequest, "recipient_emails", "between 1 and 1000 recipient_emails are required")
+
+		return nil, false
+	}
+
+	return emails, true
+}
+
+// suppressionGroup returns the unsubscribe group of the path, or writes a not found error.
+func (s *Server) suppressionGroup(w http.ResponseWriter, r *http.Request) (object, bool) {
+	group, ok := s.collection("/asm/groups").get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w)
+	}
+
+	return group, ok
+}
+
+func (s *Server) listGroupSuppressions(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.suppressionGroup(w, r); !ok {
+		return
+	}
+
+	emails := []string{}
+	for _, o := range s.collection(groupSuppressions(r.PathValue("id"))).list(nil) {
+		emails = append(emails, o.string("email"))
+	}
+
+	writeJSON(w, http.StatusOK, emails)
+}
+
+func (s *Server) addGroupSuppressions(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.suppressionGroup(w, r)
+	if !ok {
+		return
+	}
+
+	emails, ok := recipientEmails(w, r)
+	if !ok {
+		return
+	}
+
+	s.suppress(groupSuppressions(r.PathValue("id")), emails)
+	group["unsubscribes"] = len(s.collection(groupSuppressions(r.PathValue("id"))).order)
+	writeJSON(w, http.StatusCreated, object{"recipient_emails": emails})
+}
+
+// searchGroupSuppressions returns which of the emails are suppressed from the group.
+func (s *Server) searchGroupSuppressions(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.suppressionGroup(w, r); !ok {
+		return
+	}
+
+	emails, ok := recipientEmails(w, r)
+	if !ok {
+		return
+	}
+
+	found := []string{}
+
+	for _, email := range emails {
+		if o, ok := s.collection(groupSuppressions(r.PathValue("id"))).get(strings.ToLower(email)); ok {
+			found = append(found, o.string("email"))
+		}
+	}
+
+	writeJSON(w, http.StatusOK, found)
+}
+
+func (s *Server) deleteGroupSuppression(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.suppressionGroup(w, r)
+	if !ok {
+		return
+	}
+
+	c := s.collection(groupSuppressions(r.PathValue("id")))
+	if !c.delete(strings.ToLower(r.PathValue("email"))) {
+		writeNotFound(w)
+
+		return
+	}
+
+	group["unsubscribes"] = len(c.order)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) addGlobalSuppressions(w http.ResponseWriter, r *http.Request) {
+	emails, ok := recipientEmails(w, r)
+	if !ok {
+		return
+	}
+
+	s.suppress("/asm/suppressions/global", emails)
+	writeJSON(w, http.StatusCreated, object{"recipient_emails": emails})
+}
+
+// getGlobalSuppression returns {"recipient_email": email}, or {} if the email isn't suppressed.
+func (s *Server) getGlobalSuppression(w http.ResponseWriter, r *http.Request) {
+	o, ok := s.collection("/asm/suppressions/global").get(strings.ToLower(r.PathValue("email")))
+	if !ok {
+		writeJSON(w, http.StatusOK, object{})
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, object{"recipient_email": o.string("email")})
+}
+
+// listGlobalSuppressions lists the global suppressions, paginated with the limit and offset query parameters.
+func (s *Server) listGlobalSuppressions(w http.ResponseWriter, r *http.Request) {
+	suppressions := s.collection("/asm/suppressions/global").list(nil)
+
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	if err != nil || limit <= 0 || limit > 500 { //nolint:mnd
+		limit = 500
+	}
+
+	page := []object{}
+	if offset < len(suppressions) {
+		page = suppressions[offset:min(offset+limit, len(suppressions))]
+	}
+
+	writeJSON(w, http.StatusOK, page)
+}
+
+func (s *Server) deleteGlobalSuppression(w http.ResponseWriter, r *http.Request) {
+	if !s.collection("/asm/suppressions/global").delete(strings.ToLower(r.PathValue("email"))) {
+		writeNotFound(w)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AddSuppressionListEntry adds an email to a suppression list, e.g. "bounces", as when
+// SendGrid fails to deliver to it.
+func (s *Server) AddSuppressionListEntry(list, email string, created time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := object{"email": email, "created": created.Unix()}
+
+	switch list {
+	case "bounces", "blocks":
+		entry["reason"] = "550 5.1.1 The email account that you tried to reach does not exist."
+		entry["status"] = "5.1.1"
+	case "spam_reports":
+		entry["ip"] = "192.0.2.1"
+	default:
+		entry["reason"] = "Mail domain mentioned in email address is unknown"
+	}
+
+	s.collection("/suppression/"+list).put(strings.ToLower(email), entry)
+}
+
+// suppressionList returns the collection of the suppression list of the path, or writes
+// a not found error.
+func suppressionList(w http.ResponseWriter, r *http.Request) (string, bool) {
+	switch list := r.PathValue("list"); list {
+	case "bounces", "blocks", "spam_reports", "invalid_emails":
+		return "/suppression/" + list, true
+	default:
+		writeNotFound(w)
+
+		return "", false
+	}
+}
+
+// listSuppressionList lists the entries of a suppression list, filtered with the start_time,
+// end_time and email query parameters, and paginated with limit and offset.
+func (s *Server) listSuppressionList(w http.ResponseWriter, r *http.Request) {
+	list, ok := suppressionList(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	startTime, _ := strconv.ParseInt(query.Get("start_time"), 10, 64)
+	endTime, _ := strconv.ParseInt(query.Get("end_time"), 10, 64)
+	offset, _ := strconv.Atoi(query.Get("offset"))
+
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 500
+	}
+
+	entries := s.collection(list).list(func(o object) bool {
+		created, _ := o["created"].(int64)
+
+		return (startTime == 0 || created >= startTime) && (endTime == 0 || created <= endTime) &&
+			(query.Get("email") == "" || strings.EqualFold(o.string("email"), query.Get("email")))
+	})
+
+	page := []object{}
+	if offset < len(entries) {
+		page = entries[offset:min(offset+limit, len(entries))]
+	}
+
+	writeJSON(w, http.StatusOK, page)
+}
+
+// deleteSuppressionListEntries deletes the emails of the body from a suppression list.
+func (s *Server) deleteSuppressionListEntries(w http.ResponseWriter, r *http.Request) {
+	list, ok := suppressionList(w, r)
+	if !ok {
+		return
+	}
+
+	o, err := decode(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+
+		return
+	}
+
+	emails := stringList(o["emails"])
+	if len(emails) == 0 || len(emails) > maxRecipientEmails {
+		writeError(w, http.StatusBadRequest, "emails", "between 1 and 1000 emails are required")
+
+		return
+	}
+
+	for _, email := range emails {
+		s.collection(list).delete(strings.ToLower(email))
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,122 @@
+package sendgridtest
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+// The verified senders are stored in the /verified_senders collection. SendGrid lists
+// them in {"results": [...]}, paginated with the limit and lastSeenID query parameters,
+// and has no route to get a single sender.
+
+// VerifySender marks the verified senders of an email as verified, as when its owner
+// clicks the link of the verification email.
+func (s *Server) VerifySender(email string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	senders := s.collection("/verified_senders").list(func(o object) bool { return o.string("from_email") == email })
+	if len(senders) == 0 {
+		return errors.New("no verified sender for " + email)
+	}
+
+	for _, sender := range senders {
+		sender["verified"] = true
+	}
+
+	return nil
+}
+
+func (s *Server) createVerifiedSender(w http.ResponseWriter, r *http.Request) {
+	o, err := decode(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+
+		return
+	}
+
+	for _, field := range []string{"nickname", "from_email", "reply_to", "address", "city", "country"} {
+		if o.string(field) == "" {
+			writeError(w, http.StatusBadRequest, field, field+" is required")
+
+			return
+		}
+	}
+
+	id := s.nextID()
+	o["id"] = id
+	o["verified"] = false
+	o["locked"] = false
+
+	s.collection("/verified_senders").put(strconv.Itoa(id), o)
+	writeJSON(w, http.StatusCreated, o)
+}
+
+func (s *Server) listVerifiedSenders(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	lastSeenID, _ := strconv.Atoi(query.Get("lastSeenID"))
+
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = 100
+	}
+
+	page := []object{}
+
+	for _, o := range s.collection("/verified_senders").list(nil) {
+		id, _ := o["id"].(int)
+		if len(page) == limit || id <= lastSeenID || (query.Get("id") != "" && strconv.Itoa(id) != query.Get("id")) {
+			continue
+		}
+
+		page = append(page, o)
+	}
+
+	writeJSON(w, http.StatusOK, object{"results": page})
+}
+
+func (s *Server) patchVerifiedSender(w http.ResponseWriter, r *http.Request) {
+	o, ok := s.collection("/verified_senders").get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	patch, err := decode(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "", err.Error())
+
+		return
+	}
+
+	// A new from address must be verified again.
+	if email := patch.string("from_email"); email != "" && email != o.string("from_email") {
+		o["verified"] = false
+	}
+
+	delete(patch, "id")
+	delete(patch, "verified")
+	delete(patch, "locked")
+	o.merge(patch)
+
+	writeJSON(w, http.StatusOK, o)
+}
+
+func (s *Server) resendSenderVerification(w http.ResponseWriter, r *http.Request) {
+	o, ok := s.collection("/verified_senders").get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w)
+
+		return
+	}
+
+	if o["verified"] == true {
+		writeError(w, http.StatusBadRequest, "", "sender is already verified")
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,58 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// The settings of an account are singletons: SendGrid always returns them, and they
+// are changed by patching them rather than created or deleted.
+
+// readSetting retrieves the setting at the path.
+func readSetting[T any](ctx context.Context, c *Client, path string) (*T, RequestError) {
+	resp, err := c.Get(ctx, "GET", path)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        err,
+			Response:   resp,
+		}
+	}
+
+	return parseSetting[T](path, resp)
+}
+
+// patchSetting changes the setting at the path and returns it.
+func patchSetting[T any](ctx context.Context, c *Client, path string, setting interface{}) (*T, RequestError) {
+	resp, err := c.Post(ctx, "PATCH", path, setting)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w %s: %w", ErrFailedUpdatingSetting, path, err),
+			Response:   resp,
+		}
+	}
+
+	return parseSetting[T](path, resp)
+}
+
+func parseSetting[T any](path string, resp *Response) (*T, RequestError) {
+	var body T
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing setting %s: %w", path, err),
+			Response:   resp,
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
+
+// settingEnabled is the body of the requests turning a setting on or off, leaving
+// its other fields as is.
+type settingEnabled struct {
+	Enabled bool `json:"enabled"`
+}
@@ -35,7 +35,7 @@ func (c Client) CreateSSOCertificate(
 		}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "POST", "/sso/certificates", SSOCertificate{
+	resp, err := c.Post(ctx, "POST", "/sso/certificates", SSOCertificate{
 		IntegrationID:     integrationID,
 		PublicCertificate: publicCertificate,
 	})
@@ -43,17 +43,19 @@ func (c Client) CreateSSOCertificate(
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed to create SSO certificate: %w", err),
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices {
+	if resp.statusCode() >= http.StatusMultipleChoices {
 		return nil, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSSOCertificate, statusCode, respBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSSOCertificate, resp.statusCode(), resp.RawBody),
+			Response:   resp,
 		}
 	}
 
-	return parseSSOCertificate(respBody)
+	return parseSSOCertificate(resp)
 }
 
 // ReadSSOCertificate retrieves an SSO certificate by ID.
@@ -65,15 +67,16 @@ func (c Client) ReadSSOCertificate(ctx context.Context, id string) (*SSOCertific
 		}
 	}
 
-	respBody, _, err := c.Get(ctx, "GET", fmt.Sprintf("/sso/certificates/%s", id))
+	resp, err := c.Get(ctx, "GET", fmt.Sprintf("/sso/certificates/%s", id))
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: http.StatusInternalServerError,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseSSOCertificate(respBody)
+	return parseSSOCertificate(resp)
 }
 
 // UpdateSSOCertificate updates an existing SSO certificate by ID.
@@ -104,7 +107,7 @@ func (c Client) UpdateSSOCertificate(
 		}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "PATCH", fmt.Sprintf("/sso/certificates/%s", id), SSOCertificate{
+	resp, err := c.Post(ctx, "PATCH", fmt.Sprintf("/sso/certificates/%s", id), SSOCertificate{
 		IntegrationID:     integrationID,
 		PublicCertificate: publicCertificate,
 	})
@@ -112,17 +115,19 @@ func (c Client) UpdateSSOCertificate(
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed to update SSO certificate: %w", err),
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices {
+	if resp.statusCode() >= http.StatusMultipleChoices {
 		return nil, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingSSOCertificate, statusCode, respBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingSSOCertificate, resp.statusCode(), resp.RawBody),
+			Response:   resp,
 		}
 	}
 
-	return parseSSOCertificate(respBody)
+	return parseSSOCertificate(resp)
 }
 
 // DeleteSSOCertificate deletes an SSO certificate by ID.
@@ -134,10 +139,11 @@ func (c Client) DeleteSSOCertificate(ctx context.Context, id string) (bool, Requ
 		}
 	}
 
-	if _, statusCode, err := c.Get(ctx, "DELETE", fmt.Sprintf("/sso/certificates/%s", id)); statusCode > 299 || err != nil {
+	if resp, err := c.Get(ctx, "DELETE", fmt.Sprintf("/sso/certificates/%s", id)); resp.statusCode() > 299 || err != nil {
 		return false, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed deleting SSO integration: %w", err),
+			Response:   resp,
 		}
 	}
 
@@ -149,39 +155,42 @@ func (c Client) DeleteSSOCertificate(ctx context.Context, id string) (bool, Requ
 
 // ListSSOCertificates retrieves all existing SSO certificates.
 func (c Client) ListSSOCertificates(ctx context.Context) ([]*SSOCertificate, RequestError) {
-	respBody, statusCode, err := c.Get(ctx, "GET", "/sso/certificates")
-	if err != nil || statusCode >= 300 {
+	resp, err := c.Get(ctx, "GET", "/sso/certificates")
+	if err != nil || resp.statusCode() >= 300 {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseSSOCertificates(respBody)
+	return parseSSOCertificates(resp)
 }
 
-func parseSSOCertificate(respBody string) (*SSOCertificate, RequestError) {
+func parseSSOCertificate(resp *Response) (*SSOCertificate, RequestError) {
 	var body SSOCertificate
 
-	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed to parse SSO certificate: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
-func parseSSOCertificates(respBody string) ([]*SSOCertificate, RequestError) {
+func parseSSOCertificates(resp *Response) ([]*SSOCertificate, RequestError) {
 	var body []*SSOCertificate
 
-	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed to parse SSO certificates: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
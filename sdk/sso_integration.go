@@ -36,7 +36,7 @@ func (c Client) CreateSSOIntegration(
 		}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "POST", "/sso/integrations", SSOIntegration{
+	resp, err := c.Post(ctx, "POST", "/sso/integrations", SSOIntegration{
 		Name:       name,
 		Enabled:    enabled,
 		SignInURL:  signInURL,
@@ -47,17 +47,19 @@ func (c Client) CreateSSOIntegration(
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed to create SSO integration: %w", err),
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices {
+	if resp.statusCode() >= http.StatusMultipleChoices {
 		return nil, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSSOIntegration, statusCode, respBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSSOIntegration, resp.statusCode(), resp.RawBody),
+			Response:   resp,
 		}
 	}
 
-	return parseSSOIntegration(respBody)
+	return parseSSOIntegration(resp)
 }
 
 // ReadSSOIntegration retrieves an SSO integration by ID.
@@ -69,15 +71,16 @@ func (c Client) ReadSSOIntegration(ctx context.Context, id string) (*SSOIntegrat
 		}
 	}
 
-	respBody, _, err := c.Get(ctx, "GET", fmt.Sprintf("/sso/integrations/%s", id))
+	resp, err := c.Get(ctx, "GET", fmt.Sprintf("/sso/integrations/%s", id))
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: http.StatusInternalServerError,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseSSOIntegration(respBody)
+	return parseSSOIntegration(resp)
 }
 
 // UpdateSSOIntegration updates an existing SSO integration by ID.
@@ -104,7 +107,7 @@ func (c Client) UpdateSSOIntegration(
 		}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "PATCH", fmt.Sprintf("/sso/integrations/%s", id), SSOIntegration{
+	resp, err := c.Post(ctx, "PATCH", fmt.Sprintf("/sso/integrations/%s", id), SSOIntegration{
 		Name:       name,
 		Enabled:    enabled,
 		SignInURL:  signInURL,
@@ -115,17 +118,19 @@ func (c Client) UpdateSSOIntegration(
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices {
+	if resp.statusCode() >= http.StatusMultipleChoices {
 		return nil, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingSSOIntegration, statusCode, respBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingSSOIntegration, resp.statusCode(), resp.RawBody),
+			Response:   resp,
 		}
 	}
 
-	return parseSSOIntegration(respBody)
+	return parseSSOIntegration(resp)
 }
 
 // DeleteSSOIntegration deletes an SSO integration by ID.
@@ -137,10 +142,11 @@ func (c Client) DeleteSSOIntegration(ctx context.Context, id string) (bool, Requ
 		}
 	}
 
-	if _, statusCode, err := c.Get(ctx, "DELETE", fmt.Sprintf("/sso/integrations/%s", id)); statusCode > 299 || err != nil {
+	if resp, err := c.Get(ctx, "DELETE", fmt.Sprintf("/sso/integrations/%s", id)); resp.statusCode() > 299 || err != nil {
 		return false, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed to delete SSO integration: %w", err),
+			Response:   resp,
 		}
 	}
 
@@ -152,39 +158,42 @@ func (c Client) DeleteSSOIntegration(ctx context.Context, id string) (bool, Requ
 
 // ListSSOIntegrations returns a list of SSO integrations.
 func (c Client) ListSSOIntegrations(ctx context.Context) ([]*SSOIntegration, RequestError) {
-	respBody, statusCode, err := c.Get(ctx, "GET", "/sso/integrations")
-	if err != nil || statusCode >= 300 {
+	resp, err := c.Get(ctx, "GET", "/sso/integrations")
+	if err != nil || resp.statusCode() >= 300 {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseSSOIntegrations(respBody)
+	return parseSSOIntegrations(resp)
 }
 
-func parseSSOIntegration(respBody string) (*SSOIntegration, RequestError) {
+func parseSSOIntegration(resp *Response) (*SSOIntegration, RequestError) {
 	var body SSOIntegration
 
-	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed to parse SSO integration: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
-func parseSSOIntegrations(respBody string) ([]*SSOIntegration, RequestError) {
+func parseSSOIntegrations(resp *Response) ([]*SSOIntegration, RequestError) {
 	var body []*SSOIntegration
 
-	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed to parse SSO integrations: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
@@ -0,0 +1,168 @@
+package sendgrid_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+)
+
+// requestError drops the result of an SDK method.
+func requestError[T any](_ T, requestErr sendgrid.RequestError) sendgrid.RequestError {
+	return requestErr
+}
+
+// reads are the Read methods reporting the status of the API response.
+func reads(client *sendgrid.Client) map[string]func(ctx context.Context) sendgrid.RequestError {
+	return map[string]func(ctx context.Context) sendgrid.RequestError{
+		"ReadAPIKey":  func(ctx context.Context) sendgrid.RequestError { return requestError(client.ReadAPIKey(ctx, "id")) },
+		"ReadAPIKeys": func(ctx context.Context) sendgrid.RequestError { return requestError(client.ReadAPIKeys(ctx)) },
+		"ReadDomainAuthentication": func(ctx context.Context) sendgrid.RequestError {
+			return requestError(client.ReadDomainAuthentication(ctx, "1"))
+		},
+		"ReadEventWebhook": func(ctx context.Context) sendgrid.RequestError { return requestError(client.ReadEventWebhook(ctx)) },
+		"ReadEventWebhookSigning": func(ctx context.Context) sendgrid.RequestError {
+			return requestError(client.ReadEventWebhookSigning(ctx))
+		},
+		"ReadLinkBranding": func(ctx context.Context) sendgrid.RequestError {
+			return requestError(client.ReadLinkBranding(ctx, "1"))
+		},
+		"ReadParseWebhook": func(ctx context.Context) sendgrid.RequestError {
+			return requestError(client.ReadParseWebhook(ctx, "parse.example.com"))
+		},
+		"ReadSSOCertificate": func(ctx context.Context) sendgrid.RequestError {
+			return requestError(client.ReadSSOCertificate(ctx, "1"))
+		},
+		"ReadSSOIntegration": func(ctx context.Context) sendgrid.RequestError {
+			return requestError(client.ReadSSOIntegration(ctx, "1"))
+		},
+		"ReadUnsubscribeGroup": func(ctx context.Context) sendgrid.RequestError {
+			return requestError(client.ReadUnsubscribeGroup(ctx, "1"))
+		},
+		"ReadUnsubscribeGroups": func(ctx context.Context) sendgrid.RequestError {
+			return requestError(client.ReadUnsubscribeGroups(ctx))
+		},
+	}
+}
+
+func TestRead_statusCode(t *testing.T) {
+	t.Parallel()
+
+	for _, statusCode := range []int{http.StatusNotFound, http.StatusTooManyRequests, http.StatusBadGateway} {
+		server := errorServer(t, statusCode, `{"errors":[{"message":"failed"}]}`)
+		client := sendgrid.NewClient("secret", server.URL, "", sendgrid.WithRetryPolicy(sendgrid.RetryPolicy{}))
+
+		for name, read := range reads(client) {
+			requestErr := read(context.Background())
+			if requestErr.Err == nil || requestErr.StatusCode != statusCode {
+				t.Errorf("%s() on HTTP %d = %d, %v, want the status of the response", name, statusCode, requestErr.StatusCode, requestErr.Err)
+			}
+		}
+	}
+}
+
+func TestRetryOnRateLimit_retriesRateLimitedReads(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":1,"name":"newsletter"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := sendgrid.NewClient("secret", server.URL, "", sendgrid.WithRetryPolicy(sendgrid.RetryPolicy{}))
+
+	group, err := sendgrid.RetryOnRateLimit(context.Background(), time.Minute, func() (interface{}, sendgrid.RequestError) {
+		return client.ReadUnsubscribeGroup(context.Background(), "1")
+	})
+	if err != nil || group.(*sendgrid.UnsubscribeGroup).Name != "newsletter" || calls != 2 {
+		t.Errorf("RetryOnRateLimit() = %v, %v after %d calls, want the group after a retry", group, err, calls)
+	}
+}
+
+func TestDelete_alreadyDeleted(t *testing.T) {
+	t.Parallel()
+
+	server := errorServer(t, http.StatusNotFound, `{"errors":[{"message":"resource not found"}]}`)
+	client := sendgrid.NewClient("secret", server.URL, "", sendgrid.WithRetryPolicy(sendgrid.RetryPolicy{}))
+	ctx := context.Background()
+
+	deletes := map[string]func() (bool, sendgrid.RequestError){
+		"DeleteAPIKey":               func() (bool, sendgrid.RequestError) { return client.DeleteAPIKey(ctx, "id") },
+		"DeleteDomainAuthentication": func() (bool, sendgrid.RequestError) { return client.DeleteDomainAuthentication(ctx, "1") },
+		"DeleteLinkBranding":         func() (bool, sendgrid.RequestError) { return client.DeleteLinkBranding(ctx, "1") },
+		"DeleteParseWebhook":         func() (bool, sendgrid.RequestError) { return client.DeleteParseWebhook(ctx, "parse.example.com") },
+		"DeleteSubuser":              func() (bool, sendgrid.RequestError) { return client.DeleteSubuser(ctx, "someone") },
+		"DeleteUnsubscribeGroup":     func() (bool, sendgrid.RequestError) { return client.DeleteUnsubscribeGroup(ctx, "1") },
+	}
+
+	for name, del := range deletes {
+		if ok, requestErr := del(); !ok || requestErr.Err != nil {
+			t.Errorf("%s() = %t, %v, want a resource already deleted to be deleted", name, ok, requestErr.Err)
+		}
+	}
+
+	// Other errors are still returned.
+	server = errorServer(t, http.StatusForbidden, `{"errors":[{"message":"access forbidden"}]}`)
+	client = sendgrid.NewClient("secret", server.URL, "", sendgrid.WithRetryPolicy(sendgrid.RetryPolicy{}))
+
+	if ok, requestErr := client.DeleteAPIKey(ctx, "id"); ok || !errors.Is(requestErr.Err, sendgrid.ErrForbidden) {
+		t.Errorf("DeleteAPIKey() = %t, %v, want %v", ok, requestErr.Err, sendgrid.ErrForbidden)
+	}
+}
+
+func TestRetryOnRateLimit_networkError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	client := sendgrid.NewClient("secret", server.URL, "", sendgrid.WithRetryPolicy(sendgrid.RetryPolicy{}))
+
+	_, err := sendgrid.RetryOnRateLimit(context.Background(), time.Minute, func() (interface{}, sendgrid.RequestError) {
+		key, requestErr := client.ReadAPIKey(context.Background(), "id")
+		if requestErr.StatusCode != 0 || requestErr.Response != nil {
+			t.Errorf("ReadAPIKey() status = %d, want 0 without a response", requestErr.StatusCode)
+		}
+
+		return key, requestErr
+	})
+	if err == nil || !strings.Contains(err.Error(), "no response from the SendGrid API") || strings.Contains(err.Error(), "HTTP 0") {
+		t.Errorf("RetryOnRateLimit() error = %v, want a network error", err)
+	}
+}
+
+func TestValidate_noBody(t *testing.T) {
+	t.Parallel()
+
+	server := errorServer(t, http.StatusNoContent, "")
+	client := sendgrid.NewClient("secret", server.URL, "", sendgrid.WithRetryPolicy(sendgrid.RetryPolicy{}))
+	ctx := context.Background()
+
+	validates := map[string]func() (*sendgrid.DNSValidation, sendgrid.RequestError){
+		"ValidateDomainAuthentication": func() (*sendgrid.DNSValidation, sendgrid.RequestError) {
+			return client.ValidateDomainAuthentication(ctx, "1")
+		},
+		"ValidateLinkBranding": func() (*sendgrid.DNSValidation, sendgrid.RequestError) { return client.ValidateLinkBranding(ctx, "1") },
+		"ValidateReverseDNS":   func() (*sendgrid.DNSValidation, sendgrid.RequestError) { return client.ValidateReverseDNS(ctx, "1") },
+	}
+
+	for name, validate := range validates {
+		if validation, requestErr := validate(); validation != nil || !errors.Is(requestErr.Err, sendgrid.ErrFailedValidatingDNSRecords) {
+			t.Errorf("%s() on HTTP 204 = %v, %v, want %v", name, validation, requestErr.Err, sendgrid.ErrFailedValidatingDNSRecords)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package sendgrid
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -33,32 +34,34 @@ type UpdateSubUserPassword struct {
 	OldPassword string `json:"old_password"` //nolint:tagliatelle
 }
 
-func parseSubUser(respBody string) (*SubUser, RequestError) {
+func parseSubUser(resp *Response) (*SubUser, RequestError) {
 	var body SubUser
-	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
-		log.Printf("[DEBUG] [parseSubUser] failed parsing subUser, response body: %s", respBody)
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
+		log.Printf("[DEBUG] [parseSubUser] failed parsing subUser, response body: %s", resp.RawBody)
 
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
-func parseSubUsers(respBody string) ([]SubUser, RequestError) {
+func parseSubUsers(resp *Response) ([]SubUser, RequestError) {
 	var body []SubUser
-	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
-		log.Printf("[DEBUG] [parseSubUsers] failed parsing subUsers, response body: %s", respBody)
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
+		log.Printf("[DEBUG] [parseSubUsers] failed parsing subUsers, response body: %s", resp.RawBody)
 
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
 // CreateSubuser creates a subuser and returns it.
@@ -79,7 +82,7 @@ func (c *Client) CreateSubuser(ctx context.Context, username, email, password st
 		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrIPRequired}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "POST", "/subusers", SubUser{
+	resp, err := c.Post(ctx, "POST", "/subusers", SubUser{
 		UserName:        username,
 		Email:           email,
 		Password:        password,
@@ -88,19 +91,21 @@ func (c *Client) CreateSubuser(ctx context.Context, username, email, password st
 	})
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed creating subUser: %w", err),
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices {
+	if resp.statusCode() >= http.StatusMultipleChoices {
 		return nil, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSubUser, statusCode, respBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSubUser, resp.statusCode(), resp.RawBody),
+			Response:   resp,
 		}
 	}
 
-	return parseSubUser(respBody)
+	return parseSubUser(resp)
 }
 
 // ReadSubUser retreives a subuser and returns it.
@@ -111,15 +116,16 @@ func (c *Client) ReadSubUser(ctx context.Context, username string) ([]SubUser, R
 
 	endpoint := "/subusers?username=" + url.QueryEscape(username)
 
-	respBody, statusCode, err := c.Get(ctx, "GET", endpoint)
+	resp, err := c.Get(ctx, "GET", endpoint)
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed reading subUser: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return parseSubUsers(respBody)
+	return parseSubUsers(resp)
 }
 
 // UpdateSubuser enables/disables a subuser.
@@ -128,25 +134,27 @@ func (c *Client) UpdateSubuser(ctx context.Context, username string, disabled bo
 		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "PATCH", "/subusers/"+username, SubUser{
+	resp, err := c.Post(ctx, "PATCH", "/subusers/"+username, SubUser{
 		Disabled: disabled,
 	})
 	if err != nil {
 		return false, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed updating subUser: %w", err),
+			Response:   resp,
 		}
 	}
 
 	var body subUserErrors
-	if err = json.Unmarshal([]byte(respBody), &body); err != nil {
+	if err = json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
 		return false, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed updating subUser: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return len(body.Errors) == 0, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return len(body.Errors) == 0, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
 func (c *Client) UpdateSubuserIPs(ctx context.Context, username string, ips []string) RequestError {
@@ -154,39 +162,42 @@ func (c *Client) UpdateSubuserIPs(ctx context.Context, username string, ips []st
 		return RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
 	}
 
-	_, statusCode, err := c.Post(ctx, "PUT", "/subusers/"+username+"/ips", ips)
+	resp, err := c.Post(ctx, "PUT", "/subusers/"+username+"/ips", ips)
 	if err != nil {
 		return RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed updating subUser Ips: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return RequestError{StatusCode: http.StatusOK, Err: nil}
+	return RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
-// DeleteSubuser deletes a subuser.
+// DeleteSubuser deletes a subuser. A subuser already deleted is not an error.
 func (c *Client) DeleteSubuser(ctx context.Context, username string) (bool, RequestError) {
 	if username == "" {
 		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
 	}
 
-	respBody, statusCode, err := c.Get(ctx, "DELETE", "/subusers/"+username)
-	if err != nil {
+	resp, err := c.Get(ctx, "DELETE", "/subusers/"+username)
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		return false, RequestError{
-			StatusCode: http.StatusInternalServerError,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed deleting subUser: %w", err),
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+	if resp.statusCode() >= http.StatusMultipleChoices && resp.statusCode() != http.StatusNotFound { // ignore not found
 		return false, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w: statusCode: %d, respBody: %s", err, statusCode, respBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: statusCode: %d, respBody: %s", err, resp.statusCode(), resp.RawBody),
+			Response:   resp,
 		}
 	}
 
-	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
 func (c *Client) UpdateSubuserPassword(ctx context.Context, username string, oldPassword string, newPassword string) RequestError {
@@ -196,7 +207,7 @@ func (c *Client) UpdateSubuserPassword(ctx context.Context, username string, old
 
 	origOnBehalfOf := c.OnBehalfOf
 	c.OnBehalfOf = username
-	_, statusCode, err := c.Post(ctx, "PUT", "/user/password", UpdateSubUserPassword{
+	resp, err := c.Post(ctx, "PUT", "/user/password", UpdateSubUserPassword{
 		NewPassword: newPassword,
 		OldPassword: oldPassword,
 	})
@@ -206,15 +217,17 @@ func (c *Client) UpdateSubuserPassword(ctx context.Context, username string, old
 		return RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed updating subUser password: %w", err),
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices {
+	if resp.statusCode() >= http.StatusMultipleChoices {
 		return RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w: statusCode: %d", err, statusCode),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: statusCode: %d", err, resp.statusCode()),
+			Response:   resp,
 		}
 	}
 
-	return RequestError{StatusCode: http.StatusOK, Err: nil}
+	return RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
@@ -0,0 +1,246 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// suppressionBatchSize is the number of emails sent per request when adding or
+// searching suppressions.
+const suppressionBatchSize = 1000
+
+// recipientEmails is the body of the requests adding or searching suppressions.
+type recipientEmails struct {
+	RecipientEmails []string `json:"recipient_emails"` //nolint:tagliatelle
+}
+
+// globalSuppression is a global suppression as returned by SendGrid, or {} if the email isn't suppressed.
+type globalSuppression struct {
+	RecipientEmail string `json:"recipient_email"` //nolint:tagliatelle
+}
+
+func parseEmails(resp *Response) ([]string, RequestError) {
+	var body []string
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing suppressions: %w", err),
+			Response:   resp,
+		}
+	}
+
+	return body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
+
+// batches splits emails in batches of at most suppressionBatchSize emails.
+func batches(emails []string) [][]string {
+	var result [][]string
+
+	for len(emails) > suppressionBatchSize {
+		result = append(result, emails[:suppressionBatchSize])
+		emails = emails[suppressionBatchSize:]
+	}
+
+	if len(emails) > 0 {
+		result = append(result, emails)
+	}
+
+	return result
+}
+
+func groupSuppressionsPath(groupID string) string {
+	return "/asm/groups/" + groupID + "/suppressions"
+}
+
+// ReadGroupSuppressions retrieves all the emails suppressed from an UnsubscribeGroup.
+func (c *Client) ReadGroupSuppressions(ctx context.Context, groupID string) ([]string, RequestError) {
+	if groupID == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrUnsubscribeGroupIDRequired,
+		}
+	}
+
+	resp, err := c.Get(ctx, "GET", groupSuppressionsPath(groupID))
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        err,
+			Response:   resp,
+		}
+	}
+
+	return parseEmails(resp)
+}
+
+// SearchGroupSuppressions returns which of the emails are suppressed from an UnsubscribeGroup.
+func (c *Client) SearchGroupSuppressions(ctx context.Context, groupID string, emails []string) ([]string, RequestError) {
+	if groupID == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrUnsubscribeGroupIDRequired,
+		}
+	}
+
+	found := []string{}
+
+	for _, batch := range batches(emails) {
+		resp, err := c.Post(ctx, "POST", groupSuppressionsPath(groupID)+"/search", recipientEmails{RecipientEmails: batch})
+		if err != nil {
+			return nil, RequestError{
+				StatusCode: resp.statusCode(),
+				Err:        err,
+				Response:   resp,
+			}
+		}
+
+		emails, parseErr := parseEmails(resp)
+		if parseErr.Err != nil {
+			return nil, parseErr
+		}
+
+		found = append(found, emails...)
+	}
+
+	return found, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// AddGroupSuppressions suppresses emails from an UnsubscribeGroup, in batches.
+// Emails already suppressed are left as is.
+func (c *Client) AddGroupSuppressions(ctx context.Context, groupID string, emails []string) (bool, RequestError) {
+	if groupID == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrUnsubscribeGroupIDRequired,
+		}
+	}
+
+	for _, batch := range batches(emails) {
+		resp, err := c.Post(ctx, "POST", groupSuppressionsPath(groupID), recipientEmails{RecipientEmails: batch})
+		if err != nil {
+			return false, RequestError{
+				StatusCode: resp.statusCode(),
+				Err:        fmt.Errorf("%w: %w", ErrFailedAddingSuppressions, err),
+				Response:   resp,
+			}
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// DeleteGroupSuppression removes an email from the suppressions of an UnsubscribeGroup.
+func (c *Client) DeleteGroupSuppression(ctx context.Context, groupID, email string) (bool, RequestError) {
+	if groupID == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrUnsubscribeGroupIDRequired,
+		}
+	}
+
+	if email == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEmailRequired,
+		}
+	}
+
+	resp, err := c.Get(ctx, "DELETE", groupSuppressionsPath(groupID)+"/"+url.PathEscape(email))
+	if err != nil && resp.statusCode() != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedDeletingSuppression, err),
+			Response:   resp,
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
+
+// AddGlobalSuppressions suppresses emails from all the email sent, in batches.
+// Emails already suppressed are left as is.
+func (c *Client) AddGlobalSuppressions(ctx context.Context, emails []string) (bool, RequestError) {
+	for _, batch := range batches(emails) {
+		resp, err := c.Post(ctx, "POST", "/asm/suppressions/global", recipientEmails{RecipientEmails: batch})
+		if err != nil {
+			return false, RequestError{
+				StatusCode: resp.statusCode(),
+				Err:        fmt.Errorf("%w: %w", ErrFailedAddingSuppressions, err),
+				Response:   resp,
+			}
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadGlobalSuppression returns whether an email is globally suppressed.
+func (c *Client) ReadGlobalSuppression(ctx context.Context, email string) (bool, RequestError) {
+	if email == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEmailRequired,
+		}
+	}
+
+	resp, err := c.Get(ctx, "GET", "/asm/suppressions/global/"+url.PathEscape(email))
+	if err != nil {
+		return false, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        err,
+			Response:   resp,
+		}
+	}
+
+	var body globalSuppression
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing global suppression: %w", err),
+			Response:   resp,
+		}
+	}
+
+	return body.RecipientEmail != "", RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
+
+// ReadGlobalSuppressions retrieves the globally suppressed emails, reading at most maxPages
+// pages of the listing, or every page when maxPages isn't positive. It returns whether the
+// whole listing was read.
+func (c *Client) ReadGlobalSuppressions(ctx context.Context, maxPages int) ([]string, bool, RequestError) {
+	entries, complete, err := readPages[SuppressionListEntry](ctx, c, "/suppression/unsubscribes", nil, maxPageSize, maxPages)
+	if err.Err != nil {
+		return nil, false, err
+	}
+
+	emails := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		emails = append(emails, entry.Email)
+	}
+
+	return emails, complete, err
+}
+
+// DeleteGlobalSuppression removes an email from the global suppressions.
+func (c *Client) DeleteGlobalSuppression(ctx context.Context, email string) (bool, RequestError) {
+	if email == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrEmailRequired,
+		}
+	}
+
+	resp, err := c.Get(ctx, "DELETE", "/asm/suppressions/global/"+url.PathEscape(email))
+	if err != nil && resp.statusCode() != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedDeletingSuppression, err),
+			Response:   resp,
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
@@ -0,0 +1,102 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// SuppressionList is a list of emails SendGrid doesn't deliver to anymore.
+type SuppressionList string
+
+const (
+	// SuppressionBounces lists the emails whose mail server rejected a message.
+	SuppressionBounces SuppressionList = "bounces"
+	// SuppressionBlocks lists the emails whose mail server blocked a message, e.g. for its content.
+	SuppressionBlocks SuppressionList = "blocks"
+	// SuppressionSpamReports lists the emails whose recipient marked a message as spam.
+	SuppressionSpamReports SuppressionList = "spam_reports"
+	// SuppressionInvalidEmails lists the emails that don't exist or are malformed.
+	SuppressionInvalidEmails SuppressionList = "invalid_emails"
+)
+
+// SuppressionLists returns every suppression list.
+func SuppressionLists() []SuppressionList {
+	return []SuppressionList{SuppressionBounces, SuppressionBlocks, SuppressionSpamReports, SuppressionInvalidEmails}
+}
+
+func (l SuppressionList) path() string {
+	return "/suppression/" + string(l)
+}
+
+// SuppressionListEntry is an email of a suppression list. Reason is set for all the
+// lists but the spam reports, Status for the bounces and blocks, and IP for the
+// spam reports.
+type SuppressionListEntry struct {
+	Email   string `json:"email"`
+	Created int64  `json:"created"`
+	Reason  string `json:"reason,omitempty"`
+	Status  string `json:"status,omitempty"`
+	IP      string `json:"ip,omitempty"`
+}
+
+// SuppressionListFilter narrows the entries returned by ReadSuppressionList.
+type SuppressionListFilter struct {
+	// StartTime only returns the entries created at or after this Unix timestamp.
+	StartTime int64
+	// EndTime only returns the entries created at or before this Unix timestamp.
+	EndTime int64
+	// Email only returns the entries of this email.
+	Email string
+}
+
+func (f SuppressionListFilter) query() url.Values {
+	query := url.Values{}
+
+	if f.StartTime != 0 {
+		query.Set("start_time", strconv.FormatInt(f.StartTime, 10))
+	}
+
+	if f.EndTime != 0 {
+		query.Set("end_time", strconv.FormatInt(f.EndTime, 10))
+	}
+
+	if f.Email != "" {
+		query.Set("email", f.Email)
+	}
+
+	return query
+}
+
+// emailsBody is the body of the requests deleting entries of a suppression list.
+type emailsBody struct {
+	Emails []string `json:"emails"`
+}
+
+// ReadSuppressionList retrieves every entry of a suppression list matching the filter.
+func (c *Client) ReadSuppressionList(
+	ctx context.Context,
+	list SuppressionList,
+	filter SuppressionListFilter,
+) ([]SuppressionListEntry, RequestError) {
+	return readAllPages[SuppressionListEntry](ctx, c, list.path(), filter.query(), maxPageSize)
+}
+
+// DeleteSuppressionListEntries removes emails from a suppression list, in batches, so
+// that SendGrid delivers to them again. Emails missing from the list are ignored.
+func (c *Client) DeleteSuppressionListEntries(ctx context.Context, list SuppressionList, emails []string) (bool, RequestError) {
+	for _, batch := range batches(emails) {
+		resp, err := c.Post(ctx, "DELETE", list.path(), emailsBody{Emails: batch})
+		if err != nil && resp.statusCode() != http.StatusNotFound { // ignore not found
+			return false, RequestError{
+				StatusCode: resp.statusCode(),
+				Err:        fmt.Errorf("%w: %w", ErrFailedDeletingSuppression, err),
+				Response:   resp,
+			}
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
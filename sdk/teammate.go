@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -44,69 +45,74 @@ type PendingUser struct {
 	} `json:"result"`
 }
 
-func parseUser(respBody string) (*User, RequestError) {
+func parseUser(resp *Response) (*User, RequestError) {
 	var body User
 
-	err := json.Unmarshal([]byte(respBody), &body)
+	err := json.Unmarshal([]byte(resp.RawBody), &body)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed parsing teammate: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
 func (c *Client) GetUsernameByEmail(ctx context.Context, email string) (string, RequestError) {
-	respBody, statusCode, err := c.Get(ctx, "GET", "/teammates?limit=10000")
+	resp, err := c.Get(ctx, "GET", "/teammates?limit=10000")
 	if err != nil {
 		return "", RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
 	users := &Users{}
 
-	decoder := json.NewDecoder(bytes.NewReader([]byte(respBody)))
+	decoder := json.NewDecoder(bytes.NewReader([]byte(resp.RawBody)))
 	err = decoder.Decode(users)
 	if err != nil {
 		return "", RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
 	for _, user := range users.Result {
 		if user.Email == email && user.Username != "" {
-			return user.Username, RequestError{StatusCode: http.StatusOK, Err: nil}
+			return user.Username, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 		}
 	}
 	return "", RequestError{
 		StatusCode: http.StatusNotFound,
-		Err:        fmt.Errorf("username with email %s not found", email),
+		Err:        fmt.Errorf("username with email %s %w", email, ErrNotFound),
+		Response:   resp,
 	}
 }
 
 func (c *Client) CreateUser(ctx context.Context, email string, scopes []string, isAdmin bool) (*User, RequestError) {
-	respBody, statusCode, err := c.Post(ctx, "POST", "/teammates", User{
+	resp, err := c.Post(ctx, "POST", "/teammates", User{
 		Email:   email,
 		IsAdmin: isAdmin,
 		Scopes:  scopes,
 	})
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseUser(respBody)
+	return parseUser(resp)
 }
 
 func (c *Client) CreateSSOUser(ctx context.Context, firstName, lastName, email string, scopes []string, isAdmin bool) (*User, RequestError) {
-	respBody, statusCode, err := c.Post(ctx, "POST", "/sso/teammates", User{
+	resp, err := c.Post(ctx, "POST", "/sso/teammates", User{
 		FirstName: firstName,
 		LastName:  lastName,
 		Email:     email,
@@ -115,49 +121,56 @@ func (c *Client) CreateSSOUser(ctx context.Context, firstName, lastName, email s
 	})
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseUser(respBody)
+	return parseUser(resp)
 }
 
 func (c *Client) ReadUser(ctx context.Context, email string) (*User, RequestError) {
 	username, requestErr := c.GetUsernameByEmail(ctx, email)
 	if requestErr.Err != nil {
 		// If user not found in active teammates, check pending invitations
-		if requestErr.StatusCode == http.StatusNotFound {
+		if errors.Is(requestErr.Err, ErrNotFound) {
 			pendingUser, pendingErr := c.ReadPendingUser(ctx, email)
-			if pendingErr.Err != nil {
+			if errors.Is(pendingErr.Err, ErrNotFound) {
 				// User not found in either active or pending
 				return nil, RequestError{
 					StatusCode: http.StatusNotFound,
-					Err:        fmt.Errorf("user with email %s not found in active teammates or pending invitations. Original active error: %v. Pending error: %v", email, requestErr.Err, pendingErr.Err),
+					Err:        fmt.Errorf("user with email %s %w in active teammates or pending invitations. Original active error: %v. Pending error: %v", email, ErrNotFound, requestErr.Err, pendingErr.Err),
 				}
 			}
+
+			if pendingErr.Err != nil {
+				return nil, pendingErr
+			}
 			return pendingUser, RequestError{StatusCode: http.StatusOK, Err: nil}
 		}
 		return nil, requestErr
 	}
 
-	respBody, statusCode, err := c.Get(ctx, "GET", "/teammates/"+username)
+	resp, err := c.Get(ctx, "GET", "/teammates/"+username)
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
 	var u User
-	err = json.Unmarshal([]byte(respBody), &u)
+	err = json.Unmarshal([]byte(resp.RawBody), &u)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        err,
+			Response:   resp,
 		}
 	}
-	return &u, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return &u, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
 func (c *Client) UpdateUser(ctx context.Context, email string, scopes []string, isAdmin bool) (*User, RequestError) {
@@ -166,18 +179,19 @@ func (c *Client) UpdateUser(ctx context.Context, email string, scopes []string,
 		return nil, requestErr
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "PATCH", "/teammates/"+username, User{
+	resp, err := c.Post(ctx, "PATCH", "/teammates/"+username, User{
 		IsAdmin: isAdmin,
 		Scopes:  scopes,
 	})
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseUser(respBody)
+	return parseUser(resp)
 }
 
 func (c *Client) UpdateSSOUser(ctx context.Context, firstName, lastName, email string, scopes []string, isAdmin bool) (*User, RequestError) {
@@ -188,13 +202,13 @@ func (c *Client) UpdateSSOUser(ctx context.Context, firstName, lastName, email s
 		if requestErr.StatusCode == http.StatusNotFound {
 			return nil, RequestError{
 				StatusCode: http.StatusNotFound,
-				Err:        fmt.Errorf("user %s not found in active teammates - they may be pending and cannot be updated", email),
+				Err:        fmt.Errorf("user %s %w in active teammates - they may be pending and cannot be updated", email, ErrNotFound),
 			}
 		}
 		return nil, requestErr
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "PATCH", "/sso/teammates/"+username, User{
+	resp, err := c.Post(ctx, "PATCH", "/sso/teammates/"+username, User{
 		FirstName: firstName,
 		LastName:  lastName,
 		IsAdmin:   isAdmin,
@@ -202,12 +216,13 @@ func (c *Client) UpdateSSOUser(ctx context.Context, firstName, lastName, email s
 	})
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseUser(respBody)
+	return parseUser(resp)
 }
 
 func (c *Client) DeleteUser(ctx context.Context, email string) (bool, RequestError) {
@@ -218,19 +233,21 @@ func (c *Client) DeleteUser(ctx context.Context, email string) (bool, RequestErr
 			return false, tokenErr
 		}
 
-		if _, statusCode, err := c.Get(ctx, "DELETE", "/teammates/pending/"+tokenInvite); statusCode > 299 || err != nil {
+		if resp, err := c.Get(ctx, "DELETE", "/teammates/pending/"+tokenInvite); resp.statusCode() > 299 || err != nil {
 			return false, RequestError{
-				StatusCode: statusCode,
+				StatusCode: resp.statusCode(),
 				Err:        fmt.Errorf("failed deleting user: %w", err),
+				Response:   resp,
 			}
 		}
 		return false, RequestError{StatusCode: http.StatusOK, Err: nil}
 	}
 
-	if _, statusCode, err := c.Get(ctx, "DELETE", "/teammates/"+username); statusCode > 299 || err != nil {
+	if resp, err := c.Get(ctx, "DELETE", "/teammates/"+username); resp.statusCode() > 299 || err != nil {
 		return false, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed deleting user: %w", err),
+			Response:   resp,
 		}
 	}
 
@@ -238,21 +255,23 @@ func (c *Client) DeleteUser(ctx context.Context, email string) (bool, RequestErr
 }
 
 func (c *Client) GetPendingUserToken(ctx context.Context, email string) (string, RequestError) {
-	respBody, statusCode, err := c.Get(ctx, "GET", "/teammates/pending?limit=200")
+	resp, err := c.Get(ctx, "GET", "/teammates/pending?limit=200")
 	if err != nil {
 		return "", RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
 	pendingUsers := &PendingUser{}
-	decoder := json.NewDecoder(bytes.NewReader([]byte(respBody)))
+	decoder := json.NewDecoder(bytes.NewReader([]byte(resp.RawBody)))
 	err = decoder.Decode(pendingUsers)
 	if err != nil {
 		return "", RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
@@ -260,37 +279,40 @@ func (c *Client) GetPendingUserToken(ctx context.Context, email string) (string,
 		if user.Email == email {
 			// SendGrid API returns token field, not pending_id
 			if user.Token != "" {
-				return user.Token, RequestError{StatusCode: http.StatusOK, Err: nil}
+				return user.Token, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 			}
 			// Fallback to pending_id if token is empty (though this seems unlikely based on API response)
 			if user.PendingID != "" {
-				return user.PendingID, RequestError{StatusCode: http.StatusOK, Err: nil}
+				return user.PendingID, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 			}
 		}
 	}
 	return "", RequestError{
 		StatusCode: http.StatusNotFound,
-		Err:        fmt.Errorf("pending user with email %s not found", email),
+		Err:        fmt.Errorf("pending user with email %s %w", email, ErrNotFound),
+		Response:   resp,
 	}
 }
 
 // ReadPendingUser reads a pending user invitation by email
 func (c *Client) ReadPendingUser(ctx context.Context, email string) (*User, RequestError) {
-	respBody, statusCode, err := c.Get(ctx, "GET", "/teammates/pending?limit=10000")
+	resp, err := c.Get(ctx, "GET", "/teammates/pending?limit=10000")
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed to get pending users: %w", err),
+			Response:   resp,
 		}
 	}
 
 	pendingUsers := &PendingUser{}
-	decoder := json.NewDecoder(bytes.NewReader([]byte(respBody)))
+	decoder := json.NewDecoder(bytes.NewReader([]byte(resp.RawBody)))
 	err = decoder.Decode(pendingUsers)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed to decode pending users response: %w", err),
+			Response:   resp,
 		}
 	}
 
@@ -310,12 +332,13 @@ func (c *Client) ReadPendingUser(ctx context.Context, email string) (*User, Requ
 				// Mark as pending by setting a special user type
 				UserType: "pending",
 			}
-			return user, RequestError{StatusCode: http.StatusOK, Err: nil}
+			return user, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 		}
 	}
 
 	return nil, RequestError{
 		StatusCode: http.StatusNotFound,
-		Err:        fmt.Errorf("pending user with email %s not found. Available pending users: %v. This may mean the user has already accepted the invitation or the invitation has expired", email, pendingDetails),
+		Err:        fmt.Errorf("pending user with email %s %w. Available pending users: %v. This may mean the user has already accepted the invitation or the invitation has expired", email, ErrNotFound, pendingDetails),
+		Response:   resp,
 	}
 }
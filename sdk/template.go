@@ -21,32 +21,34 @@ type Templates struct {
 	Result []Template `json:"result"`
 }
 
-func parseTemplate(respBody string) (*Template, RequestError) {
+func parseTemplate(resp *Response) (*Template, RequestError) {
 	var body Template
 
-	err := json.Unmarshal([]byte(respBody), &body)
+	err := json.Unmarshal([]byte(resp.RawBody), &body)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed parsing template: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
-func parseTemplates(respBody string) ([]Template, RequestError) {
+func parseTemplates(resp *Response) ([]Template, RequestError) {
 	var body Templates
 
-	err := json.Unmarshal([]byte(respBody), &body)
+	err := json.Unmarshal([]byte(resp.RawBody), &body)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed parsing template: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return body.Result, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return body.Result, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
 // CreateTemplate creates a transactional template and returns it.
@@ -62,18 +64,19 @@ func (c *Client) CreateTemplate(ctx context.Context, name, generation string) (*
 		generation = "dynamic"
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "POST", "/templates", Template{
+	resp, err := c.Post(ctx, "POST", "/templates", Template{
 		Name:       name,
 		Generation: generation,
 	})
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed creating template: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return parseTemplate(respBody)
+	return parseTemplate(resp)
 }
 
 // ReadTemplate retreives a transactional template and returns it.
@@ -85,27 +88,29 @@ func (c *Client) ReadTemplate(ctx context.Context, id string) (*Template, Reques
 		}
 	}
 
-	respBody, statusCode, err := c.Get(ctx, "GET", "/templates/"+id)
+	resp, err := c.Get(ctx, "GET", "/templates/"+id)
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed reading template: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return parseTemplate(respBody)
+	return parseTemplate(resp)
 }
 
 func (c *Client) ReadTemplates(ctx context.Context, generation string) ([]Template, RequestError) {
-	respBody, statusCode, err := c.Get(ctx, "GET", "/templates?page_size=200&generations="+generation)
+	resp, err := c.Get(ctx, "GET", "/templates?page_size=200&generations="+generation)
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed reading template: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return parseTemplates(respBody)
+	return parseTemplates(resp)
 }
 
 // UpdateTemplate edits a transactional template and returns it.
@@ -125,17 +130,18 @@ func (c *Client) UpdateTemplate(ctx context.Context, id, name string) (*Template
 		}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "PATCH", "/templates/"+id, Template{
+	resp, err := c.Post(ctx, "PATCH", "/templates/"+id, Template{
 		Name: name,
 	})
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed updating template: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return parseTemplate(respBody)
+	return parseTemplate(resp)
 }
 
 // DeleteTemplate deletes a transactional template.
@@ -147,10 +153,11 @@ func (c *Client) DeleteTemplate(ctx context.Context, id string) (bool, RequestEr
 		}
 	}
 
-	if _, statusCode, err := c.Get(ctx, "DELETE", "/templates/"+id); statusCode > 299 || err != nil {
+	if resp, err := c.Get(ctx, "DELETE", "/templates/"+id); resp.statusCode() > 299 || err != nil {
 		return false, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed deleting template: %w", err),
+			Response:   resp,
 		}
 	}
 
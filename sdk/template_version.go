@@ -28,18 +28,19 @@ type Warning struct {
 	Message string `json:"message,omitempty"`
 }
 
-func parseTemplateVersion(respBody string) (*TemplateVersion, RequestError) {
+func parseTemplateVersion(resp *Response) (*TemplateVersion, RequestError) {
 	var body TemplateVersion
 
-	err := json.Unmarshal([]byte(respBody), &body)
+	err := json.Unmarshal([]byte(resp.RawBody), &body)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed parsing template version: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
 // CreateTemplateVersion creates a new version of a transactional template and returns it.
@@ -65,15 +66,16 @@ func (c *Client) CreateTemplateVersion(ctx context.Context, t TemplateVersion) (
 		}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "POST", "/templates/"+t.TemplateID+"/versions", t)
+	resp, err := c.Post(ctx, "POST", "/templates/"+t.TemplateID+"/versions", t)
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed creating template version: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return parseTemplateVersion(respBody)
+	return parseTemplateVersion(resp)
 }
 
 // ReadTemplateVersion retreives a version of a transactional template and returns it.
@@ -92,15 +94,16 @@ func (c *Client) ReadTemplateVersion(ctx context.Context, templateID, id string)
 		}
 	}
 
-	respBody, statusCode, err := c.Get(ctx, "GET", "/templates/"+templateID+"/versions/"+id)
+	resp, err := c.Get(ctx, "GET", "/templates/"+templateID+"/versions/"+id)
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed reading template version: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return parseTemplateVersion(respBody)
+	return parseTemplateVersion(resp)
 }
 
 // UpdateTemplateVersion edits a version of a transactional template and returns it.
@@ -119,15 +122,16 @@ func (c *Client) UpdateTemplateVersion(ctx context.Context, t TemplateVersion) (
 		}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "PATCH", "/templates/"+t.TemplateID+"/versions/"+t.ID, t)
+	resp, err := c.Post(ctx, "PATCH", "/templates/"+t.TemplateID+"/versions/"+t.ID, t)
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed updating template version: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return parseTemplateVersion(respBody)
+	return parseTemplateVersion(resp)
 }
 
 // ActivateTemplateVersion activates a version of a transactional template and returns it.
@@ -146,15 +150,16 @@ func (c *Client) ActivateTemplateVersion(ctx context.Context, t TemplateVersion)
 		}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "POST", "/templates/"+t.TemplateID+"/versions/"+t.ID+"/activate", t)
+	resp, err := c.Post(ctx, "POST", "/templates/"+t.TemplateID+"/versions/"+t.ID+"/activate", t)
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed activating template version: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return parseTemplateVersion(respBody)
+	return parseTemplateVersion(resp)
 }
 
 // DeleteTemplateVersion deletes a version of a transactional template.
@@ -166,11 +171,12 @@ func (c *Client) DeleteTemplateVersion(ctx context.Context, templateID, id strin
 		}
 	}
 
-	if _, statusCode, err := c.Get(ctx, "DELETE", "/templates/"+templateID+"/versions/"+id); statusCode > 299 ||
+	if resp, err := c.Get(ctx, "DELETE", "/templates/"+templateID+"/versions/"+id); resp.statusCode() > 299 ||
 		err != nil {
 		return false, RequestError{
-			StatusCode: statusCode,
+			StatusCode: resp.statusCode(),
 			Err:        fmt.Errorf("failed deleting template version: %w", err),
+			Response:   resp,
 		}
 	}
 
@@ -0,0 +1,122 @@
+package sendgrid
+
+import (
+	"context"
+	"net/http"
+)
+
+// TrackingSetting is the name of a tracking setting, which changes the emails to
+// report how the recipients engage with them.
+type TrackingSetting string
+
+const (
+	// TrackingSettingClick rewrites the links of the emails to track the clicks.
+	TrackingSettingClick TrackingSetting = "click"
+	// TrackingSettingOpen adds an invisible image to the emails to track the opens.
+	TrackingSettingOpen TrackingSetting = "open"
+	// TrackingSettingSubscription adds an unsubscribe link to the emails.
+	TrackingSettingSubscription TrackingSetting = "subscription"
+	// TrackingSettingGoogleAnalytics adds UTM parameters to the links of the emails.
+	TrackingSettingGoogleAnalytics TrackingSetting = "google_analytics"
+)
+
+func (s TrackingSetting) path() string {
+	return "/tracking_settings/" + string(s)
+}
+
+// TrackingSettingsClick is the tracking of the clicks on the links of the emails.
+type TrackingSettingsClick struct {
+	Enabled bool `json:"enabled"`
+	// EnableText also tracks the links of the plain text part of the emails.
+	EnableText bool `json:"enable_text"` //nolint:tagliatelle
+}
+
+// TrackingSettingsOpen is the tracking of the opens of the emails.
+type TrackingSettingsOpen struct {
+	Enabled bool `json:"enabled"`
+}
+
+// TrackingSettingsSubscription is the unsubscribe link added to the emails, and the
+// page the recipients land on once unsubscribed. The contents must contain a
+// <% %> tag, whose text becomes the link.
+type TrackingSettingsSubscription struct {
+	Enabled      bool   `json:"enabled"`
+	HTMLContent  string `json:"html_content"`  //nolint:tagliatelle
+	PlainContent string `json:"plain_content"` //nolint:tagliatelle
+	Landing      string `json:"landing"`
+	// Replace is a tag of the emails replaced by the unsubscribe link, rather than
+	// adding the link at the bottom.
+	Replace string `json:"replace"`
+	// URL is the page the recipients land on, rather than the landing HTML.
+	URL string `json:"url"`
+}
+
+// TrackingSettingsGoogleAnalytics is the UTM parameters added to the links of the emails.
+type TrackingSettingsGoogleAnalytics struct {
+	Enabled     bool   `json:"enabled"`
+	UTMSource   string `json:"utm_source"`   //nolint:tagliatelle
+	UTMMedium   string `json:"utm_medium"`   //nolint:tagliatelle
+	UTMTerm     string `json:"utm_term"`     //nolint:tagliatelle
+	UTMContent  string `json:"utm_content"`  //nolint:tagliatelle
+	UTMCampaign string `json:"utm_campaign"` //nolint:tagliatelle
+}
+
+// DisableTrackingSetting turns a tracking setting off, leaving its other fields as is.
+func (c *Client) DisableTrackingSetting(ctx context.Context, setting TrackingSetting) (bool, RequestError) {
+	if _, err := patchSetting[settingEnabled](ctx, c, setting.path(), settingEnabled{Enabled: false}); err.Err != nil {
+		return false, err
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadTrackingSettingsClick retrieves the click tracking setting.
+func (c *Client) ReadTrackingSettingsClick(ctx context.Context) (*TrackingSettingsClick, RequestError) {
+	return readSetting[TrackingSettingsClick](ctx, c, TrackingSettingClick.path())
+}
+
+// UpdateTrackingSettingsClick changes the click tracking setting and returns it.
+func (c *Client) UpdateTrackingSettingsClick(
+	ctx context.Context,
+	s TrackingSettingsClick,
+) (*TrackingSettingsClick, RequestError) {
+	return patchSetting[TrackingSettingsClick](ctx, c, TrackingSettingClick.path(), s)
+}
+
+// ReadTrackingSettingsOpen retrieves the open tracking setting.
+func (c *Client) ReadTrackingSettingsOpen(ctx context.Context) (*TrackingSettingsOpen, RequestError) {
+	return readSetting[TrackingSettingsOpen](ctx, c, TrackingSettingOpen.path())
+}
+
+// UpdateTrackingSettingsOpen changes the open tracking setting and returns it.
+func (c *Client) UpdateTrackingSettingsOpen(ctx context.Context, s TrackingSettingsOpen) (*TrackingSettingsOpen, RequestError) {
+	return patchSetting[TrackingSettingsOpen](ctx, c, TrackingSettingOpen.path(), s)
+}
+
+// ReadTrackingSettingsSubscription retrieves the subscription tracking setting.
+func (c *Client) ReadTrackingSettingsSubscription(ctx context.Context) (*TrackingSettingsSubscription, RequestError) {
+	return readSetting[TrackingSettingsSubscription](ctx, c, TrackingSettingSubscription.path())
+}
+
+// UpdateTrackingSettingsSubscription changes the subscription tracking setting and returns it.
+func (c *Client) UpdateTrackingSettingsSubscription(
+	ctx context.Context,
+	s TrackingSettingsSubscription,
+) (*TrackingSettingsSubscription, RequestError) {
+	return patchSetting[TrackingSettingsSubscription](ctx, c, TrackingSettingSubscription.path(), s)
+}
+
+// ReadTrackingSettingsGoogleAnalytics retrieves the Google Analytics tracking setting.
+func (c *Client) ReadTrackingSettingsGoogleAnalytics(
+	ctx context.Context,
+) (*TrackingSettingsGoogleAnalytics, RequestError) {
+	return readSetting[TrackingSettingsGoogleAnalytics](ctx, c, TrackingSettingGoogleAnalytics.path())
+}
+
+// UpdateTrackingSettingsGoogleAnalytics changes the Google Analytics tracking setting and returns it.
+func (c *Client) UpdateTrackingSettingsGoogleAnalytics(
+	ctx context.Context,
+	s TrackingSettingsGoogleAnalytics,
+) (*TrackingSettingsGoogleAnalytics, RequestError) {
+	return patchSetting[TrackingSettingsGoogleAnalytics](ctx, c, TrackingSettingGoogleAnalytics.path(), s)
+}
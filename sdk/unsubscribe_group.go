@@ -3,6 +3,7 @@ package sendgrid
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -16,28 +17,30 @@ type UnsubscribeGroup struct {
 	Unsubscribes int32  `json:"unsubscribes,omitempty"`
 }
 
-func parseUnsubscribeGroup(respBody string) (*UnsubscribeGroup, RequestError) {
+func parseUnsubscribeGroup(resp *Response) (*UnsubscribeGroup, RequestError) {
 	var body UnsubscribeGroup
-	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed parsing API key: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
-func parseUnsubscribeGroups(respBody string) ([]UnsubscribeGroup, RequestError) {
+func parseUnsubscribeGroups(resp *Response) ([]UnsubscribeGroup, RequestError) {
 	var body []UnsubscribeGroup
-	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed parsing API key: %w", err),
+			Response:   resp,
 		}
 	}
 
-	return body, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
 
 // CreateUnsubscribeGroup creates an UnsubscribeGroup and returns it.
@@ -54,7 +57,7 @@ func (c *Client) CreateUnsubscribeGroup(
 		}
 	}
 
-	respBody, statusCode, err := c.Post(ctx, "POST", "/asm/groups", UnsubscribeGroup{
+	resp, err := c.Post(ctx, "POST", "/asm/groups", UnsubscribeGroup{
 		Name:        name,
 		Description: description,
 		IsDefault:   isDefault,
@@ -63,17 +66,19 @@ func (c *Client) CreateUnsubscribeGroup(
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        fmt.Errorf("failed creating API key: %w", err),
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices {
+	if resp.statusCode() >= http.StatusMultipleChoices {
 		return nil, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingUnsubscribeGroup, statusCode, respBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingUnsubscribeGroup, resp.statusCode(), resp.RawBody),
+			Response:   resp,
 		}
 	}
 
-	return parseUnsubscribeGroup(respBody)
+	return parseUnsubscribeGroup(resp)
 }
 
 // ReadUnsubscribeGroup retreives an UnsubscribeGroup and returns it.
@@ -85,28 +90,30 @@ func (c *Client) ReadUnsubscribeGroup(ctx context.Context, id string) (*Unsubscr
 		}
 	}
 
-	respBody, _, err := c.Get(ctx, "GET", "/asm/groups/"+id)
+	resp, err := c.Get(ctx, "GET", "/asm/groups/"+id)
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: http.StatusInternalServerError,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseUnsubscribeGroup(respBody)
+	return parseUnsubscribeGroup(resp)
 }
 
 // ReadUnsubscribeGroups retrieves all UnsubscribeGroup and returns them.
 func (c *Client) ReadUnsubscribeGroups(ctx context.Context) ([]UnsubscribeGroup, RequestError) {
-	respBody, _, err := c.Get(ctx, "GET", "/asm/groups")
+	resp, err := c.Get(ctx, "GET", "/asm/groups")
 	if err != nil {
 		return nil, RequestError{
-			StatusCode: http.StatusInternalServerError,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseUnsubscribeGroups(respBody)
+	return parseUnsubscribeGroups(resp)
 }
 
 // UpdateUnsubscribeGroup edits an UnsubscribeGroup and returns it.
@@ -132,18 +139,19 @@ func (c *Client) UpdateUnsubscribeGroup(
 		t.Description = description
 	}
 
-	respBody, _, err := c.Post(ctx, "PATCH", "/asm/groups/"+id, t)
+	resp, err := c.Post(ctx, "PATCH", "/asm/groups/"+id, t)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	return parseUnsubscribeGroup(respBody)
+	return parseUnsubscribeGroup(resp)
 }
 
-// DeleteUnsubscribeGroup deletes an UnsubscribeGroup.
+// DeleteUnsubscribeGroup deletes an UnsubscribeGroup. An UnsubscribeGroup already deleted is not an error.
 func (c *Client) DeleteUnsubscribeGroup(ctx context.Context, id string) (bool, RequestError) {
 	if id == "" {
 		return false, RequestError{
@@ -152,20 +160,22 @@ func (c *Client) DeleteUnsubscribeGroup(ctx context.Context, id string) (bool, R
 		}
 	}
 
-	responseBody, statusCode, err := c.Get(ctx, "DELETE", "/asm/groups/"+id)
-	if err != nil {
+	resp, err := c.Get(ctx, "DELETE", "/asm/groups/"+id)
+	if err != nil && !errors.Is(err, ErrNotFound) {
 		return false, RequestError{
-			StatusCode: http.StatusInternalServerError,
+			StatusCode: resp.statusCode(),
 			Err:        err,
+			Response:   resp,
 		}
 	}
 
-	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+	if resp.statusCode() >= http.StatusMultipleChoices && resp.statusCode() != http.StatusNotFound { // ignore not found
 		return false, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingUnsubscribeGroup, statusCode, responseBody),
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingUnsubscribeGroup, resp.statusCode(), resp.RawBody),
+			Response:   resp,
 		}
 	}
 
-	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
 }
@@ -0,0 +1,212 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// verifiedSendersPageSize is the number of verified senders read per request.
+const verifiedSendersPageSize = 100
+
+// VerifiedSender is a Sendgrid single sender identity: a from address verified
+// by clicking the link of the email SendGrid sends to it.
+type VerifiedSender struct {
+	ID          int32  `json:"id,omitempty"`
+	Nickname    string `json:"nickname"`
+	FromEmail   string `json:"from_email"`              //nolint:tagliatelle
+	FromName    string `json:"from_name,omitempty"`     //nolint:tagliatelle
+	ReplyTo     string `json:"reply_to"`                //nolint:tagliatelle
+	ReplyToName string `json:"reply_to_name,omitempty"` //nolint:tagliatelle
+	Address     string `json:"address"`
+	Address2    string `json:"address2,omitempty"`
+	State       string `json:"state,omitempty"`
+	City        string `json:"city"`
+	Zip         string `json:"zip,omitempty"`
+	Country     string `json:"country"`
+	Verified    bool   `json:"verified,omitempty"`
+	Locked      bool   `json:"locked,omitempty"`
+}
+
+// verifiedSenders is a page of the listing of the verified senders.
+type verifiedSenders struct {
+	Results []VerifiedSender `json:"results"`
+}
+
+func parseVerifiedSender(resp *Response) (*VerifiedSender, RequestError) {
+	var body VerifiedSender
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing verified sender: %w", err),
+			Response:   resp,
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
+
+// readVerifiedSenders reads a page of verified senders.
+func (c *Client) readVerifiedSenders(ctx context.Context, query url.Values) ([]VerifiedSender, RequestError) {
+	resp, err := c.Get(ctx, "GET", "/verified_senders?"+query.Encode())
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        err,
+			Response:   resp,
+		}
+	}
+
+	var body verifiedSenders
+	if err := json.Unmarshal([]byte(resp.RawBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing verified senders: %w", err),
+			Response:   resp,
+		}
+	}
+
+	return body.Results, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
+
+// CreateVerifiedSender creates a VerifiedSender and returns it. SendGrid emails the
+// from address a link to verify it.
+func (c *Client) CreateVerifiedSender(ctx context.Context, sender VerifiedSender) (*VerifiedSender, RequestError) {
+	if sender.FromEmail == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrFromEmailRequired,
+		}
+	}
+
+	sender.ID = 0
+
+	resp, err := c.Post(ctx, "POST", "/verified_senders", sender)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedCreatingVerifiedSender, err),
+			Response:   resp,
+		}
+	}
+
+	return parseVerifiedSender(resp)
+}
+
+// ReadVerifiedSender retrieves a VerifiedSender and returns it. SendGrid can't
+// retrieve a single sender: it is looked up in the listing.
+func (c *Client) ReadVerifiedSender(ctx context.Context, id string) (*VerifiedSender, RequestError) {
+	if id == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrVerifiedSenderIDRequired,
+		}
+	}
+
+	senders, err := c.readVerifiedSenders(ctx, url.Values{"id": {id}})
+	if err.Err != nil {
+		return nil, err
+	}
+
+	for _, sender := range senders {
+		if strconv.Itoa(int(sender.ID)) == id {
+			return &sender, RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+	}
+
+	return nil, RequestError{
+		StatusCode: http.StatusNotFound,
+		Err:        fmt.Errorf("verified sender %s %w", id, ErrNotFound),
+	}
+}
+
+// ReadVerifiedSenders retrieves all the VerifiedSenders, reading the listing page by page.
+func (c *Client) ReadVerifiedSenders(ctx context.Context) ([]VerifiedSender, RequestError) {
+	var all []VerifiedSender
+
+	query := url.Values{"limit": {strconv.Itoa(verifiedSendersPageSize)}}
+
+	for {
+		senders, err := c.readVerifiedSenders(ctx, query)
+		if err.Err != nil {
+			return nil, err
+		}
+
+		all = append(all, senders...)
+
+		if len(senders) < verifiedSendersPageSize {
+			return all, RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+
+		query.Set("lastSeenID", strconv.Itoa(int(senders[len(senders)-1].ID)))
+	}
+}
+
+// UpdateVerifiedSender edits a VerifiedSender and returns it. Changing the from
+// address requires to verify it again.
+func (c *Client) UpdateVerifiedSender(ctx context.Context, id string, sender VerifiedSender) (*VerifiedSender, RequestError) {
+	if id == "" {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrVerifiedSenderIDRequired,
+		}
+	}
+
+	sender.ID = 0
+
+	resp, err := c.Post(ctx, "PATCH", "/verified_senders/"+id, sender)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        err,
+			Response:   resp,
+		}
+	}
+
+	return parseVerifiedSender(resp)
+}
+
+// ResendSenderVerification sends the verification email of a VerifiedSender again.
+func (c *Client) ResendSenderVerification(ctx context.Context, id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrVerifiedSenderIDRequired,
+		}
+	}
+
+	resp, err := c.Post(ctx, "POST", "/verified_senders/resend/"+id, nil)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedResendingVerification, err),
+			Response:   resp,
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
+
+// DeleteVerifiedSender deletes a VerifiedSender.
+func (c *Client) DeleteVerifiedSender(ctx context.Context, id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        ErrVerifiedSenderIDRequired,
+		}
+	}
+
+	resp, err := c.Get(ctx, "DELETE", "/verified_senders/"+id)
+	if err != nil && resp.statusCode() != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: resp.statusCode(),
+			Err:        fmt.Errorf("%w: %w", ErrFailedDeletingVerifiedSender, err),
+			Response:   resp,
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil, Response: resp}
+}
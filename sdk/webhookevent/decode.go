@@ -0,0 +1,199 @@
+package webhookevent
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ErrNotAnArray error displayed when the posted body isn't a JSON array of events.
+var ErrNotAnArray = errors.New("events must be a JSON array")
+
+// knownFields caches the JSON names of the fields of each type of event.
+var knownFields sync.Map //nolint:gochecknoglobals
+
+// fieldNames returns the JSON names of the fields of a struct type, including the
+// fields of its embedded structs.
+func fieldNames(t reflect.Type) map[string]bool {
+	if names, ok := knownFields.Load(t); ok {
+		return names.(map[string]bool)
+	}
+
+	names := map[string]bool{}
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			for name := range fieldNames(field.Type) {
+				names[name] = true
+			}
+
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			names[name] = true
+		}
+	}
+
+	knownFields.Store(t, names)
+
+	return names
+}
+
+// Unmarshal decodes a single event.
+func Unmarshal(data []byte) (Event, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed decoding the event: %w", err)
+	}
+
+	var eventType Type
+	if raw, ok := fields["event"]; !ok || json.Unmarshal(raw, &eventType) != nil || eventType == "" {
+		return nil, ErrMissingEventType
+	}
+
+	event := newEvent(eventType)
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, fmt.Errorf("failed decoding the %s event: %w", eventType, err)
+	}
+
+	base := event.EventBase()
+	known := fieldNames(reflect.TypeOf(event).Elem())
+
+	for name, raw := range fields {
+		if known[name] {
+			continue
+		}
+
+		if base.Unknown == nil {
+			base.Unknown = map[string]json.RawMessage{}
+		}
+
+		base.Unknown[name] = raw
+
+		var value string
+		if json.Unmarshal(raw, &value) == nil {
+			if base.UniqueArgs == nil {
+				base.UniqueArgs = map[string]string{}
+			}
+
+			base.UniqueArgs[name] = value
+		}
+	}
+
+	return event, nil
+}
+
+// Marshal encodes an event, with its unknown fields.
+func Marshal(event Event) ([]byte, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	unknown := event.EventBase().Unknown
+	if len(unknown) == 0 {
+		return data, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	for name, raw := range unknown {
+		if _, ok := fields[name]; !ok {
+			fields[name] = raw
+		}
+	}
+
+	return json.Marshal(fields)
+}
+
+// Decoder reads the events of a batch one at a time, without loading the whole
+// batch in memory.
+type Decoder struct {
+	dec     *json.Decoder
+	started bool
+	err     error
+}
+
+// NewDecoder creates a Decoder reading the JSON array of events posted by SendGrid.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{dec: json.NewDecoder(r)}
+}
+
+// Next returns the next event of the batch, or io.EOF after the last one. An event
+// which can't be decoded returns an error, and the following events can still be read.
+// An invalid array stops the decoding, and every following call returns its error.
+func (d *Decoder) Next() (Event, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	if !d.started {
+		token, err := d.dec.Token()
+		if err != nil {
+			return nil, d.fail(err)
+		}
+
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			return nil, d.fail(ErrNotAnArray)
+		}
+
+		d.started = true
+	}
+
+	if !d.dec.More() {
+		if _, err := d.dec.Token(); err != nil {
+			return nil, d.fail(err)
+		}
+
+		d.err = io.EOF
+
+		return nil, io.EOF
+	}
+
+	var raw json.RawMessage
+	if err := d.dec.Decode(&raw); err != nil {
+		return nil, d.fail(err)
+	}
+
+	return Unmarshal(raw)
+}
+
+func (d *Decoder) fail(err error) error {
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+
+	d.err = fmt.Errorf("failed decoding the events: %w", err)
+
+	return d.err
+}
+
+// DecodeAll reads every event of a batch.
+func DecodeAll(r io.Reader) ([]Event, error) {
+	dec := NewDecoder(r)
+
+	var events []Event
+
+	for {
+		event, err := dec.Next()
+		if errors.Is(err, io.EOF) {
+			return events, nil
+		}
+
+		if err != nil {
+			return events, err
+		}
+
+		events = append(events, event)
+	}
+}
@@ -0,0 +1,265 @@
+// Package webhookevent decodes the events posted by a SendGrid event webhook.
+//
+// SendGrid posts the events in batches, as a JSON array. A Decoder reads the array
+// one event at a time, and returns each event as the type matching its event field,
+// e.g. a *Delivered or a *Click:
+//
+//	dec := webhookevent.NewDecoder(r.Body)
+//	for {
+//		event, err := dec.Next()
+//		if errors.Is(err, io.EOF) {
+//			break
+//		}
+//		if err != nil {
+//			return err
+//		}
+//
+//		switch event := event.(type) {
+//		case *webhookevent.Bounce:
+//			log.Printf("%s bounced: %s", event.Email, event.Reason)
+//		case *webhookevent.Click:
+//			log.Printf("%s clicked %s", event.Email, event.URL)
+//		}
+//	}
+//
+// The fields the type of an event doesn't define, e.g. the unique arguments of the
+// message or the fields SendGrid adds later, are kept in Base.Unknown, and Marshal
+// writes them back.
+package webhookevent
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Type is the type of an event, as posted in its event field.
+type Type string
+
+// The types of events. They match the event flags of the webhook, except the spam
+// reports, which SendGrid posts as "spamreport".
+const (
+	TypeProcessed        Type = "processed"
+	TypeDropped          Type = "dropped"
+	TypeDelivered        Type = "delivered"
+	TypeDeferred         Type = "deferred"
+	TypeBounce           Type = "bounce"
+	TypeOpen             Type = "open"
+	TypeClick            Type = "click"
+	TypeSpamReport       Type = "spamreport"
+	TypeUnsubscribe      Type = "unsubscribe"
+	TypeGroupUnsubscribe Type = "group_unsubscribe"
+	TypeGroupResubscribe Type = "group_resubscribe"
+)
+
+// ErrMissingEventType error displayed when an event has no event field.
+var ErrMissingEventType = errors.New("missing event type")
+
+// Event is an event posted by the webhook, e.g. a *Delivered. The events of a type
+// this package doesn't know are returned as an *Other.
+type Event interface {
+	// EventBase returns the fields every event has.
+	EventBase() *Base
+}
+
+// Categories are the categories of the message of an event. SendGrid posts a single
+// category as a string, and several as an array.
+type Categories []string
+
+// UnmarshalJSON decodes a category or an array of categories.
+func (c *Categories) UnmarshalJSON(data []byte) error {
+	var category string
+	if err := json.Unmarshal(data, &category); err == nil {
+		*c = Categories{category}
+
+		return nil
+	}
+
+	var categories []string
+	if err := json.Unmarshal(data, &categories); err != nil {
+		return fmt.Errorf("category must be a string or an array of strings: %w", err)
+	}
+
+	*c = categories
+
+	return nil
+}
+
+// Base holds the fields every event has.
+type Base struct {
+	Event       Type       `json:"event"`
+	Email       string     `json:"email"`
+	Timestamp   int64      `json:"timestamp"`
+	SMTPID      string     `json:"smtp-id,omitempty"` //nolint:tagliatelle
+	Category    Categories `json:"category,omitempty"`
+	SGEventID   string     `json:"sg_event_id"`   //nolint:tagliatelle
+	SGMessageID string     `json:"sg_message_id"` //nolint:tagliatelle
+
+	// UniqueArgs are the unique and custom arguments of the message, which SendGrid
+	// posts next to the fields of the event. They are the string fields of Unknown.
+	UniqueArgs map[string]string `json:"-"`
+	// Unknown holds the raw fields the type of the event doesn't define.
+	Unknown map[string]json.RawMessage `json:"-"`
+}
+
+// EventBase returns the fields every event has.
+func (b *Base) EventBase() *Base {
+	return b
+}
+
+// Time returns the time of the event.
+func (b *Base) Time() time.Time {
+	return time.Unix(b.Timestamp, 0)
+}
+
+// Pool is the IP pool a message was sent from.
+type Pool struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// URLOffset locates the clicked link in the message.
+type URLOffset struct {
+	Index int    `json:"index"`
+	Type  string `json:"type"`
+}
+
+// Processed is posted when a message is received and ready to be delivered.
+type Processed struct {
+	Base
+
+	Pool   *Pool `json:"pool,omitempty"`
+	SendAt int64 `json:"send_at,omitempty"` //nolint:tagliatelle
+}
+
+// Dropped is posted when a message is not delivered, e.g. to a suppressed recipient.
+type Dropped struct {
+	Base
+
+	Reason string `json:"reason"`
+	Status string `json:"status,omitempty"`
+}
+
+// Delivered is posted when the receiving server accepts a message.
+type Delivered struct {
+	Base
+
+	Response string `json:"response"`
+	IP       string `json:"ip,omitempty"`
+	TLS      int    `json:"tls,omitempty"`
+	CertErr  int    `json:"cert_err,omitempty"` //nolint:tagliatelle
+}
+
+// Deferred is posted when the receiving server temporarily rejects a message.
+type Deferred struct {
+	Base
+
+	Response string `json:"response"`
+	Attempt  string `json:"attempt"`
+	IP       string `json:"ip,omitempty"`
+	TLS      int    `json:"tls,omitempty"`
+	CertErr  int    `json:"cert_err,omitempty"` //nolint:tagliatelle
+}
+
+// Bounce is posted when the receiving server permanently rejects a message. Its
+// Type is "bounce", or "blocked" when the rejection may not last.
+type Bounce struct {
+	Base
+
+	Type                 string `json:"type"`
+	Reason               string `json:"reason"`
+	Status               string `json:"status"`
+	BounceClassification string `json:"bounce_classification,omitempty"` //nolint:tagliatelle
+	IP                   string `json:"ip,omitempty"`
+	TLS                  int    `json:"tls,omitempty"`
+	CertErr              int    `json:"cert_err,omitempty"` //nolint:tagliatelle
+}
+
+// Open is posted when a recipient opens a message. SGMachineOpen is true when it was
+// opened by a mail client fetching the images, e.g. Apple Mail Privacy Protection.
+type Open struct {
+	Base
+
+	UserAgent     string `json:"useragent"`
+	IP            string `json:"ip"`
+	SGMachineOpen bool   `json:"sg_machine_open"` //nolint:tagliatelle
+}
+
+// Click is posted when a recipient clicks a link.
+type Click struct {
+	Base
+
+	URL       string     `json:"url"`
+	URLOffset *URLOffset `json:"url_offset,omitempty"` //nolint:tagliatelle
+	UserAgent string     `json:"useragent"`
+	IP        string     `json:"ip"`
+}
+
+// SpamReport is posted when a recipient marks a message as spam.
+type SpamReport struct {
+	Base
+}
+
+// Unsubscribe is posted when a recipient unsubscribes from every email.
+type Unsubscribe struct {
+	Base
+}
+
+// GroupUnsubscribe is posted when a recipient unsubscribes from an unsubscribe group.
+type GroupUnsubscribe struct {
+	Base
+
+	ASMGroupID int        `json:"asm_group_id"` //nolint:tagliatelle
+	URL        string     `json:"url,omitempty"`
+	URLOffset  *URLOffset `json:"url_offset,omitempty"` //nolint:tagliatelle
+	UserAgent  string     `json:"useragent,omitempty"`
+	IP         string     `json:"ip,omitempty"`
+}
+
+// GroupResubscribe is posted when a recipient subscribes to an unsubscribe group again.
+type GroupResubscribe struct {
+	Base
+
+	ASMGroupID int        `json:"asm_group_id"` //nolint:tagliatelle
+	URL        string     `json:"url,omitempty"`
+	URLOffset  *URLOffset `json:"url_offset,omitempty"` //nolint:tagliatelle
+	UserAgent  string     `json:"useragent,omitempty"`
+	IP         string     `json:"ip,omitempty"`
+}
+
+// Other is an event of a type this package doesn't know. All its other fields are
+// kept in Unknown.
+type Other struct {
+	Base
+}
+
+// newEvent returns an empty event of the type.
+func newEvent(eventType Type) Event {
+	switch eventType {
+	case TypeProcessed:
+		return &Processed{}
+	case TypeDropped:
+		return &Dropped{}
+	case TypeDelivered:
+		return &Delivered{}
+	case TypeDeferred:
+		return &Deferred{}
+	case TypeBounce:
+		return &Bounce{}
+	case TypeOpen:
+		return &Open{}
+	case TypeClick:
+		return &Click{}
+	case TypeSpamReport:
+		return &SpamReport{}
+	case TypeUnsubscribe:
+		return &Unsubscribe{}
+	case TypeGroupUnsubscribe:
+		return &GroupUnsubscribe{}
+	case TypeGroupResubscribe:
+		return &GroupResubscribe{}
+	}
+
+	return &Other{}
+}
@@ -0,0 +1,273 @@
+package webhookevent_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/webhookevent"
+)
+
+func readFixture(t *testing.T) []webhookevent.Event {
+	t.Helper()
+
+	f, err := os.Open("testdata/events.json")
+	if err != nil {
+		t.Fatalf("failed opening the fixture: %v", err)
+	}
+	defer f.Close()
+
+	events, err := webhookevent.DecodeAll(f)
+	if err != nil {
+		t.Fatalf("DecodeAll() error = %v", err)
+	}
+
+	return events
+}
+
+func TestDecodeAll(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	events := readFixture(t)
+
+	tests := []struct {
+		eventType webhookevent.Type
+		want      webhookevent.Event
+	}{
+		{
+			eventType: webhookevent.TypeProcessed,
+			want:      &webhookevent.Processed{Pool: &webhookevent.Pool{ID: 210, Name: "new_MY_test"}},
+		},
+		{
+			eventType: webhookevent.TypeDropped,
+			want:      &webhookevent.Dropped{Reason: "Bounced Address", Status: "5.0.0"},
+		},
+		{
+			eventType: webhookevent.TypeDelivered,
+			want:      &webhookevent.Delivered{Response: "250 OK", IP: "168.1.1.1", TLS: 1},
+		},
+		{
+			eventType: webhookevent.TypeDeferred,
+			want:      &webhookevent.Deferred{Response: "400 try again later", Attempt: "5"},
+		},
+		{
+			eventType: webhookevent.TypeBounce,
+			want: &webhookevent.Bounce{
+				Type: "bounce", Reason: "500 unknown recipient", Status: "5.0.0", BounceClassification: "Invalid Address",
+			},
+		},
+		{
+			eventType: webhookevent.TypeOpen,
+			want: &webhookevent.Open{
+				UserAgent: "Mozilla/4.0 (compatible; MSIE 6.1; Windows XP; .NET CLR 1.1.4322; .NET CLR 2.0.50727)",
+				IP:        "255.255.255.255",
+			},
+		},
+		{
+			eventType: webhookevent.TypeClick,
+			want: &webhookevent.Click{
+				URL:       "http://www.sendgrid.com/",
+				URLOffset: &webhookevent.URLOffset{Index: 0, Type: "html"},
+				UserAgent: "Mozilla/4.0 (compatible; MSIE 6.1; Windows XP; .NET CLR 1.1.4322; .NET CLR 2.0.50727)",
+				IP:        "255.255.255.255",
+			},
+		},
+		{
+			eventType: webhookevent.TypeSpamReport,
+			want:      &webhookevent.SpamReport{},
+		},
+		{
+			eventType: webhookevent.TypeUnsubscribe,
+			want:      &webhookevent.Unsubscribe{},
+		},
+		{
+			eventType: webhookevent.TypeGroupUnsubscribe,
+			want: &webhookevent.GroupUnsubscribe{
+				ASMGroupID: 10,
+				URL:        "http://www.sendgrid.com/",
+				UserAgent:  "Mozilla/4.0 (compatible; MSIE 6.1; Windows XP; .NET CLR 1.1.4322; .NET CLR 2.0.50727)",
+				IP:         "255.255.255.255",
+			},
+		},
+		{
+			eventType: webhookevent.TypeGroupResubscribe,
+			want: &webhookevent.GroupResubscribe{
+				ASMGroupID: 10,
+				URL:        "http://www.sendgrid.com/",
+				UserAgent:  "Mozilla/4.0 (compatible; MSIE 6.1; Windows XP; .NET CLR 1.1.4322; .NET CLR 2.0.50727)",
+				IP:         "255.255.255.255",
+			},
+		},
+		{
+			eventType: "account_status_change",
+			want:      &webhookevent.Other{},
+		},
+	}
+
+	if len(events) != len(tests) {
+		t.Fatalf("DecodeAll() returned %d events, want %d", len(events), len(tests))
+	}
+
+	for i, tt := range tests {
+		t.Run(string(tt.eventType), func(t *testing.T) {
+			t.Parallel()
+
+			event := events[i]
+			base := event.EventBase()
+
+			if base.Event != tt.eventType {
+				t.Errorf("event = %q, want %q", base.Event, tt.eventType)
+			}
+
+			if base.SGEventID == "" || base.Email != "example@test.com" || base.Time().Unix() != 1513299569 {
+				t.Errorf("base = %+v, want the common fields", base)
+			}
+
+			if tt.eventType != "account_status_change" && base.SGMessageID == "" {
+				t.Error("sg_message_id is empty")
+			}
+
+			// Compare the fields of the type, the base being checked above.
+			*tt.want.EventBase() = *base
+			if !reflect.DeepEqual(event, tt.want) {
+				t.Errorf("event = %+v, want %+v", event, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeAll_categories(t *testing.T) {
+	t.Parallel()
+
+	events := readFixture(t)
+
+	if got, want := events[0].EventBase().Category, (webhookevent.Categories{"cat facts"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("single category = %v, want %v", got, want)
+	}
+
+	if got, want := events[1].EventBase().Category, (webhookevent.Categories{"cat facts", "weekly"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("categories = %v, want %v", got, want)
+	}
+}
+
+func TestUnmarshal_unknownFields(t *testing.T) {
+	t.Parallel()
+
+	data := `{"event":"delivered","email":"a@example.com","timestamp":1,"sg_event_id":"e","sg_message_id":"m",` +
+		`"response":"250 OK","order_id":"42","retries":3}`
+
+	event, err := webhookevent.Unmarshal([]byte(data))
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	base := event.EventBase()
+
+	if want := map[string]string{"order_id": "42"}; !reflect.DeepEqual(base.UniqueArgs, want) {
+		t.Errorf("unique args = %v, want %v", base.UniqueArgs, want)
+	}
+
+	if len(base.Unknown) != 2 || string(base.Unknown["retries"]) != "3" {
+		t.Errorf("unknown = %v, want order_id and retries", base.Unknown)
+	}
+
+	encoded, err := webhookevent.Marshal(event)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var got, want map[string]interface{}
+	if err := json.Unmarshal(encoded, &got); err != nil {
+		t.Fatalf("failed decoding %s: %v", encoded, err)
+	}
+
+	_ = json.Unmarshal([]byte(data), &want)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Marshal() = %v, want %v", got, want)
+	}
+}
+
+func TestUnmarshal_errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{name: "not an object", data: `[]`},
+		{name: "no event", data: `{"email":"a@example.com"}`, wantErr: webhookevent.ErrMissingEventType},
+		{name: "event not a string", data: `{"event":1}`, wantErr: webhookevent.ErrMissingEventType},
+		{name: "wrong field type", data: `{"event":"click","url":1}`},
+		{name: "wrong category type", data: `{"event":"open","category":1}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := webhookevent.Unmarshal([]byte(tt.data))
+			if err == nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Unmarshal() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	t.Parallel()
+
+	dec := webhookevent.NewDecoder(strings.NewReader(
+		`[{"event":"open","email":"a@example.com"}, {"event":"click","url":1}, {"event":"bounce","type":"blocked"}]`,
+	))
+
+	if event, err := dec.Next(); err != nil || event.EventBase().Email != "a@example.com" {
+		t.Fatalf("Next() = %v, %v, want the open event", event, err)
+	}
+
+	// An invalid event doesn't stop the decoding.
+	if _, err := dec.Next(); err == nil {
+		t.Fatal("Next() error = nil, want the invalid click")
+	}
+
+	if event, err := dec.Next(); err != nil || event.(*webhookevent.Bounce).Type != "blocked" {
+		t.Fatalf("Next() = %v, %v, want the bounce event", event, err)
+	}
+
+	for range 2 {
+		if _, err := dec.Next(); !errors.Is(err, io.EOF) {
+			t.Fatalf("Next() error = %v, want %v", err, io.EOF)
+		}
+	}
+}
+
+func TestDecoder_errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		body    string
+		wantErr error
+	}{
+		{name: "empty", body: "", wantErr: io.ErrUnexpectedEOF},
+		{name: "object", body: `{"event":"open"}`, wantErr: webhookevent.ErrNotAnArray},
+		{name: "truncated", body: `[{"event":"open"},`},
+		{name: "invalid", body: `[{"event":"open"} x]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			events, err := webhookevent.DecodeAll(strings.NewReader(tt.body))
+			if err == nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("DecodeAll() = %d events, error %v, want %v", len(events), err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+// Package webhookverify verifies the signature of the events posted by a SendGrid
+// event webhook.
+//
+// When the signing of an event webhook is enabled, SendGrid signs the timestamp and
+// the raw body of every request with an ECDSA key, and returns the public key, e.g.
+// from ConfigureEventWebhookSigning or the public_key attribute of the
+// sendgrid_event_webhook resource. A Verifier checks the signature with that key:
+//
+//	verifier, err := webhookverify.New(publicKey)
+//	if err != nil {
+//		return err
+//	}
+//
+//	http.Handle("/events", verifier.Middleware(eventsHandler))
+package webhookverify
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader is the header holding the base64 ECDSA signature of a request.
+	SignatureHeader = "X-Twilio-Email-Event-Webhook-Signature"
+	// TimestampHeader is the header holding the Unix time at which a request was signed.
+	TimestampHeader = "X-Twilio-Email-Event-Webhook-Timestamp"
+
+	// DefaultMaxSkew is how far the timestamp of a request can be from the current time.
+	DefaultMaxSkew = 5 * time.Minute
+	// DefaultMaxBodySize is the size of the largest body a request can have, in bytes.
+	DefaultMaxBodySize = 10 << 20
+)
+
+var (
+	// ErrInvalidPublicKey error displayed when the public key isn't a base64 ECDSA public key.
+	ErrInvalidPublicKey = errors.New("invalid public key")
+
+	// ErrMissingSignature error displayed when a request has no signature.
+	ErrMissingSignature = errors.New("missing signature")
+
+	// ErrMissingTimestamp error displayed when a request has no timestamp.
+	ErrMissingTimestamp = errors.New("missing timestamp")
+
+	// ErrInvalidTimestamp error displayed when the timestamp of a request isn't a Unix time.
+	ErrInvalidTimestamp = errors.New("invalid timestamp")
+
+	// ErrTimestampSkew error displayed when the timestamp of a request is too far from
+	// the current time, e.g. when a request is replayed.
+	ErrTimestampSkew = errors.New("timestamp outside of the allowed skew")
+
+	// ErrInvalidSignature error displayed when the signature doesn't match the request.
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrBodyTooLarge error displayed when the body of a request exceeds the maximum size.
+	ErrBodyTooLarge = errors.New("body too large")
+)
+
+// ParsePublicKey parses the public key returned by SendGrid: a base64 DER encoded
+// ECDSA public key.
+func ParsePublicKey(publicKey string) (*ecdsa.PublicKey, error) {
+	der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(publicKey))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPublicKey, err)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPublicKey, err)
+	}
+
+	ecdsaKey, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: got a %T, want an ECDSA key", ErrInvalidPublicKey, key)
+	}
+
+	return ecdsaKey, nil
+}
+
+// Verifier verifies the requests signed by an event webhook.
+type Verifier struct {
+	key         *ecdsa.PublicKey
+	maxSkew     time.Duration
+	maxBodySize int64
+	now         func() time.Time
+}
+
+// Option customizes a Verifier created by New or NewWithKey.
+type Option func(*Verifier)
+
+// WithMaxSkew sets how far the timestamp of a request can be from the current time,
+// in either direction. A zero or negative skew accepts any timestamp.
+func WithMaxSkew(maxSkew time.Duration) Option {
+	return func(v *Verifier) {
+		v.maxSkew = maxSkew
+	}
+}
+
+// WithMaxBodySize sets the size of the largest body the middleware reads, in bytes.
+func WithMaxBodySize(maxBodySize int64) Option {
+	return func(v *Verifier) {
+		if maxBodySize > 0 {
+			v.maxBodySize = maxBodySize
+		}
+	}
+}
+
+// WithClock makes the Verifier check the timestamps against the given clock, e.g. in tests.
+func WithClock(now func() time.Time) Option {
+	return func(v *Verifier) {
+		if now != nil {
+			v.now = now
+		}
+	}
+}
+
+// New creates a Verifier checking the signatures with the public key returned by SendGrid.
+func New(publicKey string, opts ...Option) (*Verifier, error) {
+	key, err := ParsePublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWithKey(key, opts...), nil
+}
+
+// NewWithKey creates a Verifier checking the signatures with a parsed public key.
+func NewWithKey(key *ecdsa.PublicKey, opts ...Option) *Verifier {
+	v := &Verifier{
+		key:         key,
+		maxSkew:     DefaultMaxSkew,
+		maxBodySize: DefaultMaxBodySize,
+		now:         time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// Verify checks the signature and the timestamp of a request against its raw body,
+// which must be exactly the bytes SendGrid posted.
+func (v *Verifier) Verify(signature, timestamp string, body []byte) error {
+	if signature == "" {
+		return ErrMissingSignature
+	}
+
+	if timestamp == "" {
+		return ErrMissingTimestamp
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %q", ErrInvalidTimestamp, timestamp)
+	}
+
+	if v.maxSkew > 0 {
+		skew := v.now().Sub(time.Unix(seconds, 0))
+		if skew > v.maxSkew || skew < -v.maxSkew {
+			return fmt.Errorf("%w: signed %s away from now", ErrTimestampSkew, skew.Abs())
+		}
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+	}
+
+	// SendGrid signs the timestamp followed by the body.
+	hash := sha256.New()
+	hash.Write([]byte(timestamp))
+	hash.Write(body)
+
+	if !ecdsa.VerifyASN1(v.key, hash.Sum(nil), sig) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// VerifyRequest reads the body of a request and checks its signature. The body is
+// returned, and also left readable in the request.
+func (v *Verifier) VerifyRequest(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, v.maxBodySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading the body: %w", err)
+	}
+
+	if int64(len(body)) > v.maxBodySize {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrBodyTooLarge, v.maxBodySize)
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err := v.Verify(r.Header.Get(SignatureHeader), r.Header.Get(TimestampHeader), body); err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// Middleware only passes the requests with a valid signature to the next handler.
+// The others are rejected with 403 Forbidden, or 413 Request Entity Too Large when
+// their body exceeds the maximum size.
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := v.VerifyRequest(r); err != nil {
+			status := http.StatusForbidden
+			if errors.Is(err, ErrBodyTooLarge) {
+				status = http.StatusRequestEntityTooLarge
+			}
+
+			http.Error(w, err.Error(), status)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
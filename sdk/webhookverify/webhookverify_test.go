@@ -0,0 +1,289 @@
+package webhookverify_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/webhookverify"
+)
+
+const body = `[{"email":"example@test.com","event":"processed","sg_event_id":"rbtnWrG1DVDGGGFHFyun0A"}]`
+
+var now = time.Unix(1_700_000_000, 0)
+
+func generateKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed generating a key: %v", err)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("failed encoding the public key: %v", err)
+	}
+
+	return key, base64.StdEncoding.EncodeToString(der)
+}
+
+// sign signs a request the way SendGrid does.
+func sign(t *testing.T, key *ecdsa.PrivateKey, timestamp, body string) string {
+	t.Helper()
+
+	hash := sha256.Sum256([]byte(timestamp + body))
+
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	if err != nil {
+		t.Fatalf("failed signing: %v", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+func TestParsePublicKey(t *testing.T) {
+	t.Parallel()
+
+	_, publicKey := generateKey(t)
+
+	ed25519Key, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed generating a key: %v", err)
+	}
+
+	ed25519DER, err := x509.MarshalPKIXPublicKey(ed25519Key)
+	if err != nil {
+		t.Fatalf("failed encoding the public key: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		publicKey string
+		wantErr   bool
+	}{
+		{name: "ecdsa", publicKey: publicKey},
+		{name: "surrounding spaces", publicKey: " " + publicKey + "\n"},
+		{name: "empty", publicKey: "", wantErr: true},
+		{name: "not base64", publicKey: "not a key!", wantErr: true},
+		{name: "not a key", publicKey: base64.StdEncoding.EncodeToString([]byte("not a key")), wantErr: true},
+		{name: "not ecdsa", publicKey: base64.StdEncoding.EncodeToString(ed25519DER), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := webhookverify.ParsePublicKey(tt.publicKey)
+			if tt.wantErr {
+				if !errors.Is(err, webhookverify.ErrInvalidPublicKey) {
+					t.Errorf("ParsePublicKey() error = %v, want %v", err, webhookverify.ErrInvalidPublicKey)
+				}
+
+				return
+			}
+
+			if err != nil || key == nil {
+				t.Errorf("ParsePublicKey() = %v, %v, want a key", key, err)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	key, publicKey := generateKey(t)
+	otherKey, _ := generateKey(t)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	tests := []struct {
+		name      string
+		signature string
+		timestamp string
+		body      string
+		opts      []webhookverify.Option
+		wantErr   error
+	}{
+		{
+			name:      "valid",
+			signature: sign(t, key, timestamp, body),
+			timestamp: timestamp,
+			body:      body,
+		},
+		{
+			name:      "tampered body",
+			signature: sign(t, key, timestamp, body),
+			timestamp: timestamp,
+			body:      strings.Replace(body, "processed", "delivered", 1),
+			wantErr:   webhookverify.ErrInvalidSignature,
+		},
+		{
+			name:      "tampered timestamp",
+			signature: sign(t, key, timestamp, body),
+			timestamp: strconv.FormatInt(now.Unix()+1, 10),
+			body:      body,
+			wantErr:   webhookverify.ErrInvalidSignature,
+		},
+		{
+			name:      "other key",
+			signature: sign(t, otherKey, timestamp, body),
+			timestamp: timestamp,
+			body:      body,
+			wantErr:   webhookverify.ErrInvalidSignature,
+		},
+		{
+			name:      "signature not base64",
+			signature: "not a signature!",
+			timestamp: timestamp,
+			body:      body,
+			wantErr:   webhookverify.ErrInvalidSignature,
+		},
+		{
+			name:      "missing signature",
+			timestamp: timestamp,
+			body:      body,
+			wantErr:   webhookverify.ErrMissingSignature,
+		},
+		{
+			name:      "missing timestamp",
+			signature: sign(t, key, "", body),
+			body:      body,
+			wantErr:   webhookverify.ErrMissingTimestamp,
+		},
+		{
+			name:      "invalid timestamp",
+			signature: sign(t, key, "yesterday", body),
+			timestamp: "yesterday",
+			body:      body,
+			wantErr:   webhookverify.ErrInvalidTimestamp,
+		},
+		{
+			name:      "stale timestamp",
+			signature: sign(t, key, "1699999000", body),
+			timestamp: "1699999000",
+			body:      body,
+			wantErr:   webhookverify.ErrTimestampSkew,
+		},
+		{
+			name:      "future timestamp",
+			signature: sign(t, key, "1700001000", body),
+			timestamp: "1700001000",
+			body:      body,
+			wantErr:   webhookverify.ErrTimestampSkew,
+		},
+		{
+			name:      "stale timestamp within a larger skew",
+			signature: sign(t, key, "1699999000", body),
+			timestamp: "1699999000",
+			body:      body,
+			opts:      []webhookverify.Option{webhookverify.WithMaxSkew(time.Hour)},
+		},
+		{
+			name:      "skew check disabled",
+			signature: sign(t, key, "1", body),
+			timestamp: "1",
+			body:      body,
+			opts:      []webhookverify.Option{webhookverify.WithMaxSkew(0)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := append([]webhookverify.Option{webhookverify.WithClock(func() time.Time { return now })}, tt.opts...)
+
+			verifier, err := webhookverify.New(publicKey, opts...)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+
+			err = verifier.Verify(tt.signature, tt.timestamp, []byte(tt.body))
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	key, publicKey := generateKey(t)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	verifier, err := webhookverify.New(publicKey,
+		webhookverify.WithClock(func() time.Time { return now }),
+		webhookverify.WithMaxBodySize(int64(len(body))),
+	)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	handler := verifier.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(received)
+	}))
+
+	tests := []struct {
+		name       string
+		signature  string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "valid",
+			signature:  sign(t, key, timestamp, body),
+			body:       body,
+			wantStatus: http.StatusOK,
+			wantBody:   body,
+		},
+		{
+			name:       "invalid signature",
+			signature:  sign(t, key, timestamp, "[]"),
+			body:       body,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "body too large",
+			signature:  sign(t, key, timestamp, body+" "),
+			body:       body + " ",
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(tt.body))
+			r.Header.Set(webhookverify.SignatureHeader, tt.signature)
+			r.Header.Set(webhookverify.TimestampHeader, timestamp)
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.wantStatus, w.Body)
+			}
+
+			if tt.wantBody != "" && w.Body.String() != tt.wantBody {
+				t.Errorf("handler received %q, want %q", w.Body, tt.wantBody)
+			}
+		})
+	}
+}
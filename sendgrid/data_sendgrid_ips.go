@@ -0,0 +1,228 @@
+package sendgrid
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSendgridIPs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSendgridIPsRead,
+
+		Schema: map[string]*schema.Schema{
+			"subuser": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the IP addresses assigned to this subuser.",
+			},
+			"pool": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list the IP addresses in this IP pool.",
+				ValidateFunc: validation.StringLenBetween(1, ipPoolNameLength),
+			},
+			"assigned": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Only list the IP addresses assigned to at least one subuser when true, " +
+					"or to none when false.",
+			},
+			"warmup": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list the IP addresses in warmup when true, or out of warmup when false.",
+			},
+			"exclude_whitelabels": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Leave out the IP addresses with a reverse DNS record.",
+			},
+			"addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The listed IP addresses.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The details of the listed IP addresses.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address.",
+						},
+						"pools": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The IP pools the IP address is in.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"subusers": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The subusers the IP address is assigned to.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"assigned": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if the IP address is assigned to at least one subuser.",
+						},
+						"warmup": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if the IP address is in warmup.",
+						},
+						"warmup_start_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the warmup of the IP address started, in RFC 3339 format.",
+						},
+						"whitelabeled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if the IP address has a reverse DNS record.",
+						},
+						"rdns": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reverse DNS record of the IP address.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ipFilter holds the filters of the sendgrid_ips data source that SendGrid doesn't apply.
+type ipFilter struct {
+	pool     string
+	assigned *bool
+	warmup   *bool
+}
+
+func (f ipFilter) keep(ip sendgrid.IPAddress) bool {
+	if f.pool != "" && !slices.Contains(ip.Pools, f.pool) {
+		return false
+	}
+
+	if f.assigned != nil && *f.assigned != (len(ip.Subusers) > 0) {
+		return false
+	}
+
+	return f.warmup == nil || *f.warmup == ip.Warmup
+}
+
+// optionalBool returns the value of a boolean argument, or nil if it isn't configured.
+func optionalBool(d *schema.ResourceData, name string) *bool {
+	if d.GetRawConfig().GetAttr(name).IsNull() {
+		return nil
+	}
+
+	value := d.Get(name).(bool)
+
+	return &value
+}
+
+// unixTime formats a SendGrid timestamp in RFC 3339, or returns "" if it is not set.
+func unixTime(timestamp int64) string {
+	if timestamp == 0 {
+		return ""
+	}
+
+	return time.Unix(timestamp, 0).UTC().Format(time.RFC3339)
+}
+
+func dataSendgridIPsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	apiFilter := sendgrid.IPFilter{
+		Subuser:            d.Get("subuser").(string),
+		ExcludeWhitelabels: d.Get("exclude_whitelabels").(bool),
+	}
+	filter := ipFilter{
+		pool:     d.Get("pool").(string),
+		assigned: optionalBool(d, "assigned"),
+		warmup:   optionalBool(d, "warmup"),
+	}
+
+	tflog.Debug(ctx, "Reading IP addresses", map[string]interface{}{"subuser": apiFilter.Subuser, "pool": filter.pool})
+
+	ipsStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, sendgrid.RequestError) {
+		return c.ReadIPs(ctx, apiFilter)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	addresses := []string{}
+	ips := []interface{}{}
+
+	for _, ip := range ipsStruct.([]sendgrid.IPAddress) {
+		if !filter.keep(ip) {
+			continue
+		}
+
+		addresses = append(addresses, ip.IP)
+		ips = append(ips, map[string]interface{}{
+			"ip":                ip.IP,
+			"pools":             ip.Pools,
+			"subusers":          ip.Subusers,
+			"assigned":          len(ip.Subusers) > 0,
+			"warmup":            ip.Warmup,
+			"warmup_start_date": unixTime(ip.StartDate),
+			"whitelabeled":      ip.Whitelabeled,
+			"rdns":              ip.RDNS,
+		})
+	}
+
+	d.SetId(dataSendgridIPsID(apiFilter, filter))
+
+	if err := d.Set("addresses", addresses); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(d.Set("ips", ips))
+}
+
+// dataSendgridIPsID returns an ID identifying the filters of the data source.
+func dataSendgridIPsID(apiFilter sendgrid.IPFilter, filter ipFilter) string {
+	query := url.Values{}
+
+	if apiFilter.Subuser != "" {
+		query.Set("subuser", apiFilter.Subuser)
+	}
+
+	if apiFilter.ExcludeWhitelabels {
+		query.Set("exclude_whitelabels", "true")
+	}
+
+	if filter.pool != "" {
+		query.Set("pool", filter.pool)
+	}
+
+	if filter.assigned != nil {
+		query.Set("assigned", strconv.FormatBool(*filter.assigned))
+	}
+
+	if filter.warmup != nil {
+		query.Set("warmup", strconv.FormatBool(*filter.warmup))
+	}
+
+	return "ips?" + query.Encode()
+}
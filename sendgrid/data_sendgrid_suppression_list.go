@@ -0,0 +1,188 @@
+package sendgrid
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// suppressionListFields are the fields SendGrid returns for the entries of each
+// suppression list, besides the email and the creation time.
+var suppressionListFields = map[sendgrid.SuppressionList]map[string]string{
+	sendgrid.SuppressionBounces: {
+		"reason": "Why the email bounced, as given by the mail server of the recipient.",
+		"status": "The enhanced SMTP status code of the bounce, e.g. 5.1.1.",
+	},
+	sendgrid.SuppressionBlocks: {
+		"reason": "Why the email was blocked, as given by the mail server of the recipient.",
+		"status": "The SMTP status code of the block.",
+	},
+	sendgrid.SuppressionSpamReports: {
+		"ip": "The IP address the reported email was sent from.",
+	},
+	sendgrid.SuppressionInvalidEmails: {
+		"reason": "Why the email is invalid.",
+	},
+}
+
+// dataSendgridSuppressionList returns a data source listing the entries of a suppression list.
+func dataSendgridSuppressionList(list sendgrid.SuppressionList) *schema.Resource {
+	entry := map[string]*schema.Schema{
+		"email": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The suppressed email.",
+		},
+		"created": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the email was added to the list, in RFC 3339 format.",
+		},
+	}
+
+	for name, description := range suppressionListFields[list] {
+		entry[name] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: description,
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			return dataSendgridSuppressionListRead(ctx, d, m, list)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"start_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list the emails added at or after this time, in RFC 3339 format.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list the emails added at or before this time, in RFC 3339 format.",
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list the entries of this email.",
+				ValidateFunc: validation.StringLenBetween(1, maxEmailLength),
+			},
+			"emails": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The listed emails.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"entries": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The details of the listed emails.",
+				Elem:        &schema.Resource{Schema: entry},
+			},
+		},
+	}
+}
+
+// rfc3339Time returns the Unix timestamp of an RFC 3339 argument, or 0 if it isn't set.
+func rfc3339Time(d *schema.ResourceData, name string) (int64, error) {
+	value := d.Get(name).(string)
+	if value == "" {
+		return 0, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.Unix(), nil
+}
+
+func dataSendgridSuppressionListRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+	list sendgrid.SuppressionList,
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	startTime, err := rfc3339Time(d, "start_time")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	endTime, err := rfc3339Time(d, "end_time")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filter := sendgrid.SuppressionListFilter{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Email:     d.Get("email").(string),
+	}
+
+	tflog.Debug(ctx, "Reading suppression list", map[string]interface{}{"list": string(list), "email": filter.Email})
+
+	entriesStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, sendgrid.RequestError) {
+		return c.ReadSuppressionList(ctx, list, filter)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	emails := []string{}
+	entries := []interface{}{}
+
+	for _, e := range entriesStruct.([]sendgrid.SuppressionListEntry) {
+		emails = append(emails, e.Email)
+
+		entry := map[string]interface{}{
+			"email":   e.Email,
+			"created": unixTime(e.Created),
+		}
+
+		fields := suppressionListFields[list]
+		for name, value := range map[string]string{"reason": e.Reason, "status": e.Status, "ip": e.IP} {
+			if _, ok := fields[name]; ok {
+				entry[name] = value
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	d.SetId(dataSendgridSuppressionListID(d, list))
+
+	if err := d.Set("emails", emails); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(d.Set("entries", entries))
+}
+
+// dataSendgridSuppressionListID returns an ID identifying the list and the filters of the data source.
+func dataSendgridSuppressionListID(d *schema.ResourceData, list sendgrid.SuppressionList) string {
+	query := url.Values{}
+
+	for _, name := range []string{"start_time", "end_time", "email"} {
+		if value := d.Get(name).(string); value != "" {
+			query.Set(name, value)
+		}
+	}
+
+	return string(list) + "?" + query.Encode()
+}
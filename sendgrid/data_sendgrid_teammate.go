@@ -62,7 +62,7 @@ func dataSendgridTeammateRead(context context.Context, d *schema.ResourceData, m
 	email := d.Get("email").(string)
 	tflog.Debug(context, "Reading user", map[string]interface{}{"email": email})
 
-	teammateStruct, err := sendgrid.RetryOnRateLimit(context, d, func() (interface{}, sendgrid.RequestError) {
+	teammateStruct, err := sendgrid.RetryOnRateLimit(context, d.Timeout(schema.TimeoutRead), func() (interface{}, sendgrid.RequestError) {
 		return client.ReadUser(context, email)
 	})
 	if err != nil {
@@ -59,7 +59,7 @@ func dataSendgridTemplateRead(context context.Context, d *schema.ResourceData, m
 			generation = "dynamic"
 		}
 
-		templatesStruct, err := sendgrid.RetryOnRateLimit(context, d, func() (interface{}, sendgrid.RequestError) {
+		templatesStruct, err := sendgrid.RetryOnRateLimit(context, d.Timeout(schema.TimeoutRead), func() (interface{}, sendgrid.RequestError) {
 			return c.ReadTemplates(context, generation)
 		})
 		if err != nil {
@@ -8,22 +8,70 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func dataSendgridTemplateVersion() *schema.Resource {
-	s := resourceSendgridTemplateVersion().Schema
-
-	for key, val := range s {
-		if key != "template_id" {
-			val.Computed = true
-			val.Optional = false
-			val.Required = false
-			val.Default = nil
-			val.ValidateFunc = nil
-		}
-	}
-
+func dataSendgridTemplateVersion() *schema.Resource { //nolint:funlen
 	return &schema.Resource{
 		ReadContext: dataSendgridTemplateVersionRead,
-		Schema:      s,
+		Schema: map[string]*schema.Schema{
+			"template_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the transactional template.",
+				Required:    true,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Description: "The date and time that this transactional template version was updated.",
+				Computed:    true,
+			},
+			"thumbnail_url": {
+				Type:        schema.TypeString,
+				Description: "A thumbnail preview of the template's html content.",
+				Computed:    true,
+			},
+			"active": {
+				Type: schema.TypeInt,
+				Description: "Set the version as the active version associated with the template. " +
+					"Only one version of a template can be active. " +
+					"The first version created for a template will automatically be set to Active. Allowed values: 0, 1.",
+				Computed: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the transactional template version, max length: 100.",
+				Computed:    true,
+			},
+			"html_content": {
+				Type:        schema.TypeString,
+				Description: "The HTML content of the version, maximum of 1048576 bytes allowed.",
+				Computed:    true,
+			},
+			"plain_content": {
+				Type:        schema.TypeString,
+				Description: "Text/plain content of the transactional template version, maximum of 1048576 bytes allowed.",
+				Computed:    true,
+			},
+			"generate_plain_content": {
+				Type: schema.TypeBool,
+				Description: "If true (default), plain_content is always generated from html_content. " +
+					"If false, plain_content is not altered.",
+				Computed: true,
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Description: "Subject of the new transactional template version, max length: 255.",
+				Computed:    true,
+			},
+			"editor": {
+				Type:        schema.TypeString,
+				Description: "The editor used in the UI, allowed values: code (default), design.",
+				Computed:    true,
+			},
+			"test_data": {
+				Type: schema.TypeString,
+				Description: "For dynamic templates only, " +
+					"the mock json data that will be used for template preview and test sends.",
+				Computed: true,
+			},
+		},
 	}
 }
 
@@ -31,7 +79,7 @@ func dataSendgridTemplateVersionRead(ctx context.Context, d *schema.ResourceData
 	templateID := d.Get("template_id").(string)
 	c := m.(*sendgrid.Client)
 
-	templateStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	templateStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, sendgrid.RequestError) {
 		return c.ReadTemplate(ctx, templateID)
 	})
 	if err != nil {
@@ -61,3 +109,43 @@ func dataSendgridTemplateVersionRead(ctx context.Context, d *schema.ResourceData
 
 	return nil
 }
+
+func parseTemplateVersion(d *schema.ResourceData, templateVersion *sendgrid.TemplateVersion) error {
+	if err := d.Set("updated_at", templateVersion.UpdatedAt); err != nil {
+		return ErrSetTemplateVersionUpdatedAt
+	}
+
+	if err := d.Set("thumbnail_url", templateVersion.ThumbnailURL); err != nil {
+		return ErrSetTemplateVersionThumbnailURL
+	}
+
+	if err := d.Set("active", templateVersion.Active); err != nil {
+		return ErrSetTemplateVersionActive
+	}
+
+	if err := d.Set("name", templateVersion.Name); err != nil {
+		return ErrSetTemplateVersionName
+	}
+
+	if err := d.Set("html_content", templateVersion.HTMLContent); err != nil {
+		return ErrSetTemplateVersionHTMLContent
+	}
+
+	if err := d.Set("plain_content", templateVersion.PlainContent); err != nil {
+		return ErrSetTemplateVersionPlainContent
+	}
+
+	if err := d.Set("generate_plain_content", templateVersion.GeneratePlainContent); err != nil {
+		return ErrSetTemplateVersionGenPlainContent
+	}
+
+	if err := d.Set("subject", templateVersion.Subject); err != nil {
+		return ErrSetTemplateVersionSubject
+	}
+
+	if err := d.Set("editor", templateVersion.Editor); err != nil {
+		return ErrSetTemplateVersionEditor
+	}
+
+	return nil
+}
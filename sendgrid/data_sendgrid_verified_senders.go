@@ -0,0 +1,111 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSendgridVerifiedSenders() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSendgridVerifiedSendersRead,
+
+		Schema: map[string]*schema.Schema{
+			"verified": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only list the verified senders when true, or the senders not verified yet when false.",
+			},
+			"senders": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The listed senders.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the sender.",
+						},
+						"nickname": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The nickname of the sender.",
+						},
+						"from_email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email address to send from.",
+						},
+						"from_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name shown as sender.",
+						},
+						"reply_to": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The email address replies are sent to.",
+						},
+						"verified": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True once the from address was verified.",
+						},
+						"locked": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True while the sender is used by a campaign and can't be changed.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSendgridVerifiedSendersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	verified := optionalBool(d, "verified")
+
+	tflog.Debug(ctx, "Reading verified senders")
+
+	sendersStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, sendgrid.RequestError) {
+		return c.ReadVerifiedSenders(ctx)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	senders := []interface{}{}
+
+	for _, sender := range sendersStruct.([]sendgrid.VerifiedSender) {
+		if verified != nil && *verified != sender.Verified {
+			continue
+		}
+
+		senders = append(senders, map[string]interface{}{
+			"id":         fmt.Sprint(sender.ID),
+			"nickname":   sender.Nickname,
+			"from_email": sender.FromEmail,
+			"from_name":  sender.FromName,
+			"reply_to":   sender.ReplyTo,
+			"verified":   sender.Verified,
+			"locked":     sender.Locked,
+		})
+	}
+
+	id := "verified_senders"
+	if verified != nil {
+		id += "?verified=" + strconv.FormatBool(*verified)
+	}
+
+	d.SetId(id)
+
+	return diag.FromErr(d.Set("senders", senders))
+}
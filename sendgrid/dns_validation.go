@@ -0,0 +1,116 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultValidationInterval is the time between two validations of DNS records.
+const defaultValidationInterval = "30s"
+
+// validationIntervalSchema is the interval argument of the validation resources.
+func validationIntervalSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeString,
+		Description: "How long to wait between two validations, e.g. 10s or 1m. " +
+			"The validation gives up after the create timeout.",
+		Optional:         true,
+		ForceNew:         true,
+		Default:          defaultValidationInterval,
+		ValidateDiagFunc: validatePositiveDuration,
+	}
+}
+
+// validationRecordsSchema is the records attribute of the validation resources.
+func validationRecordsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Description: "The DNS records checked by the last validation, e.g. mail_cname, and whether they were valid.",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeBool},
+	}
+}
+
+// waitForDNSValidation validates the DNS records of a domain authentication or link branding
+// every interval, until they are all valid or the create timeout expires. The records still
+// invalid are reported with the reasons given by SendGrid. validate is given a context
+// cancelled by the timeout.
+func waitForDNSValidation(
+	ctx context.Context,
+	d *schema.ResourceData,
+	kind, id string,
+	validate func(context.Context) (*sendgrid.DNSValidation, sendgrid.RequestError),
+) diag.Diagnostics {
+	interval, parseErr := time.ParseDuration(d.Get("interval").(string))
+	if parseErr != nil || interval <= 0 {
+		return diag.Errorf("interval must be a duration greater than zero, e.g. 10s or 1m: %q", d.Get("interval"))
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		validationStruct, err := sendgrid.RetryOnRateLimit(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+			return validate(ctx)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		validation := validationStruct.(*sendgrid.DNSValidation)
+		if validation.Valid {
+			return diag.FromErr(d.Set("records", validationRecords(validation)))
+		}
+
+		tflog.Debug(ctx, "DNS records not valid yet", map[string]interface{}{
+			"kind":    kind,
+			"id":      id,
+			"invalid": validation.InvalidRecords(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return dnsValidationDiagnostics(kind, id, timeout, validation)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// validationRecords returns whether each DNS record of a validation is valid.
+func validationRecords(validation *sendgrid.DNSValidation) map[string]interface{} {
+	records := map[string]interface{}{}
+	for name, result := range validation.ValidationResults {
+		records[name] = result.Valid
+	}
+
+	return records
+}
+
+// dnsValidationDiagnostics reports each DNS record still invalid after the timeout.
+func dnsValidationDiagnostics(kind, id string, timeout time.Duration, validation *sendgrid.DNSValidation) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, name := range validation.InvalidRecords() {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("DNS record %s of %s %s is not valid", name, kind, id),
+			Detail: fmt.Sprintf("%s\n\nThe record was still not valid after %s. DNS changes can take a while to propagate: "+
+				"check the record, then apply again or raise the create timeout.",
+				validation.ValidationResults[name].Reason, timeout),
+		})
+	}
+
+	if len(diags) == 0 {
+		diags = diag.Errorf("%s %s is not valid after %s", kind, id, timeout)
+	}
+
+	return diags
+}
@@ -0,0 +1,33 @@
+package sendgrid
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWaitForDNSValidation_timeoutContext(t *testing.T) {
+	t.Parallel()
+
+	d := resourceSendgridDomainAuthenticationValidation().TestResourceData()
+
+	//nolint:errcheck
+	d.Set("interval", defaultValidationInterval)
+
+	diags := waitForDNSValidation(context.Background(), d, "domain authentication", "1",
+		func(ctx context.Context) (*sendgrid.DNSValidation, sendgrid.RequestError) {
+			// A hanging validation request must be cancelled by the timeout.
+			deadline, ok := ctx.Deadline()
+			if !ok || deadline.After(time.Now().Add(d.Timeout(schema.TimeoutCreate))) {
+				t.Errorf("validation context deadline = %v (%t), want the create timeout", deadline, ok)
+			}
+
+			return &sendgrid.DNSValidation{Valid: true}, sendgrid.RequestError{}
+		})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
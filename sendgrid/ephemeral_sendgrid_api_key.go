@@ -0,0 +1,237 @@
+/*
+Provide an ephemeral resource to create an API key for the duration of a Terraform run.
+Example Usage
+```hcl
+
+	ephemeral "sendgrid_api_key" "deploy" {
+		name   = "deploy-${terraform.workspace}"
+		scopes = ["mail.send"]
+	}
+
+```
+The key is revoked once Terraform no longer needs it, and is never written to the state or the plan.
+*/
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/ephemeral/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiKeyPrivateKey is the key of the private data holding the ID of the API key to revoke on Close.
+const apiKeyPrivateKey = "api_key"
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure      = &apiKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &apiKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose          = &apiKeyEphemeralResource{}
+)
+
+// apiKeyEphemeralResource creates an API key when opened and revokes it when closed.
+type apiKeyEphemeralResource struct {
+	client *sendgrid.Client
+}
+
+func newAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &apiKeyEphemeralResource{}
+}
+
+type apiKeyEphemeralResourceModel struct {
+	Name     types.String   `tfsdk:"name"`
+	Scopes   types.Set      `tfsdk:"scopes"`
+	ID       types.String   `tfsdk:"api_key_id"`
+	APIKey   types.String   `tfsdk:"api_key"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// apiKeyPrivateData is the private data of an opened API key.
+type apiKeyPrivateData struct {
+	ID string `json:"api_key_id"` //nolint:tagliatelle
+}
+
+func (r *apiKeyEphemeralResource) Metadata(
+	_ context.Context,
+	req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *apiKeyEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `Creates a SendGrid API key for the duration of a Terraform run, and revokes it afterwards.
+
+Unlike the ` + "`sendgrid_api_key`" + ` resource, the key is never written to the plan or the state. Use it to hand a short-lived key to another provider, e.g. as a Vault or Kubernetes secret. Requires Terraform 1.10 or later.`,
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name you will use to describe this API Key.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, maxStringLength),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				Description: "The individual permissions that you are giving to this API Key. " +
+					"'sender_verification_eligible' is always added. " +
+					"Cannot include '2fa_exempt' or '2fa_required' as these are managed automatically by SendGrid.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"api_key_id": schema.StringAttribute{
+				Description: "The ID of the API key.",
+				Computed:    true,
+			},
+			"api_key": schema.StringAttribute{
+				Description: "The API key created by the API.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (r *apiKeyEphemeralResource) Configure(
+	_ context.Context,
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) {
+	r.client = providerClient(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *apiKeyEphemeralResource) ValidateConfig(
+	ctx context.Context,
+	req ephemeral.ValidateConfigRequest,
+	resp *ephemeral.ValidateConfigResponse,
+) {
+	var data apiKeyEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Scopes.IsUnknown() {
+		return
+	}
+
+	var scopes []types.String
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+
+	known := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !scope.IsUnknown() {
+			known = append(known, scope.ValueString())
+		}
+	}
+
+	resp.Diagnostics.Append(validateTeammateScopes(known, path.Root("scopes"))...)
+}
+
+func (r *apiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data apiKeyEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Open(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	var scopes []string
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if ok := scopeInScopes(scopes, "sender_verification_eligible"); !ok {
+		scopes = append(scopes, "sender_verification_eligible")
+	}
+
+	name := data.Name.ValueString()
+
+	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+		return r.client.CreateAPIKey(ctx, name, scopes)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(err.Error(), "")
+
+		return
+	}
+
+	apiKey := apiKeyStruct.(*sendgrid.APIKey)
+
+	// Terraform only closes an ephemeral resource opened without error: a key which
+	// can't be handed over is revoked right away, or it would never be.
+	private, jsonErr := json.Marshal(apiKeyPrivateData{ID: apiKey.ID})
+	if jsonErr != nil {
+		resp.Diagnostics.AddError("Invalid private data", "could not encode the API key ID: "+jsonErr.Error())
+		resp.Diagnostics.Append(r.revoke(ctx, apiKey.ID)...)
+
+		return
+	}
+
+	tflog.Debug(ctx, "Opened ephemeral API key", map[string]interface{}{"api_key_id": apiKey.ID, "name": name})
+
+	data.ID = types.StringValue(apiKey.ID)
+	data.APIKey = types.StringValue(apiKey.APIKey)
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiKeyPrivateKey, private)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(r.revoke(ctx, apiKey.ID)...)
+	}
+}
+
+func (r *apiKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, apiKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var data apiKeyPrivateData
+	if err := json.Unmarshal(private, &data); err != nil {
+		resp.Diagnostics.AddError("Invalid private data", "could not decode the API key ID: "+err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(r.revoke(ctx, data.ID)...)
+}
+
+// revoke deletes an ephemeral API key.
+func (r *apiKeyEphemeralResource) revoke(ctx context.Context, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, defaultDeleteTimeout, func() (interface{}, sendgrid.RequestError) {
+		return r.client.DeleteAPIKey(ctx, id)
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to revoke the ephemeral API key "+id,
+			err.Error()+"\n\nThe key is still valid: revoke it in the SendGrid dashboard.",
+		)
+
+		return diags
+	}
+
+	tflog.Debug(ctx, "Revoked ephemeral API key", map[string]interface{}{"api_key_id": id})
+
+	return diags
+}
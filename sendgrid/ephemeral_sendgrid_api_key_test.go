@@ -0,0 +1,144 @@
+package sendgrid
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ephemeralAPIKeySchema returns the schema of the sendgrid_api_key ephemeral resource.
+func ephemeralAPIKeySchema(t *testing.T, p *testProvider) *tfprotov5.Schema {
+	t.Helper()
+
+	resp, err := p.server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema() error = %v", err)
+	}
+
+	schema, ok := resp.EphemeralResourceSchemas["sendgrid_api_key"]
+	if !ok {
+		t.Fatal("GetProviderSchema() is missing the ephemeral resource sendgrid_api_key")
+	}
+
+	return schema
+}
+
+func TestAPIKeyEphemeralResource_openAndClose(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	p := newTestProvider(t, server.URL)
+	schema := ephemeralAPIKeySchema(t, p)
+
+	openResp, err := p.server.OpenEphemeralResource(ctx, &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "sendgrid_api_key",
+		Config: p.dynamicValue(schema, map[string]interface{}{
+			"name":   "deploy",
+			"scopes": []string{"mail.send"},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("OpenEphemeralResource() error = %v", err)
+	}
+
+	p.checkDiagnostics("OpenEphemeralResource", openResp.Diagnostics)
+
+	var result map[string]tftypes.Value
+	if err = p.decode(schema, openResp.Result).As(&result); err != nil {
+		t.Fatalf("decoding the result: %v", err)
+	}
+
+	var id, secret string
+	if err = result["api_key_id"].As(&id); err != nil {
+		t.Fatalf("decoding api_key_id: %v", err)
+	}
+
+	if err = result["api_key"].As(&secret); err != nil {
+		t.Fatalf("decoding api_key: %v", err)
+	}
+
+	if !strings.HasPrefix(secret, "SG.") {
+		t.Errorf("api_key = %q, want a secret", secret)
+	}
+
+	apiKey, readErr := server.Client().ReadAPIKey(ctx, id)
+	if readErr.Err != nil {
+		t.Fatalf("ReadAPIKey() error = %v", readErr.Err)
+	}
+
+	if apiKey.Name != "deploy" || !scopeInScopes(apiKey.Scopes, "mail.send") ||
+		!scopeInScopes(apiKey.Scopes, "sender_verification_eligible") {
+		t.Errorf("ReadAPIKey() = %+v, want the deploy key with the mail.send scope", apiKey)
+	}
+
+	closeResp, err := p.server.CloseEphemeralResource(ctx, &tfprotov5.CloseEphemeralResourceRequest{
+		TypeName: "sendgrid_api_key",
+		Private:  openResp.Private,
+	})
+	if err != nil {
+		t.Fatalf("CloseEphemeralResource() error = %v", err)
+	}
+
+	p.checkDiagnostics("CloseEphemeralResource", closeResp.Diagnostics)
+
+	if _, readErr = server.Client().ReadAPIKey(ctx, id); !errors.Is(readErr.Err, sendgrid.ErrNotFound) {
+		t.Errorf("ReadAPIKey() after Close error = %v, want %v", readErr.Err, sendgrid.ErrNotFound)
+	}
+}
+
+func TestAPIKeyEphemeralResource_validateScopes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		scopes  []string
+		wantErr string
+	}{
+		{name: "valid scopes", scopes: []string{"mail.send", "sender_verification_eligible"}},
+		{name: "no scopes"},
+		{name: "automatic scope", scopes: []string{"mail.send", "2fa_required"}, wantErr: "Automatic scopes cannot be manually assigned"},
+		{name: "invalid scope", scopes: []string{"mail.sendd"}, wantErr: "Invalid or unassignable scopes"},
+	}
+
+	p := newTestProvider(t, "http://localhost")
+	schema := ephemeralAPIKeySchema(t, p)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := map[string]interface{}{"name": "deploy"}
+			if tt.scopes != nil {
+				config["scopes"] = tt.scopes
+			}
+
+			resp, err := p.server.ValidateEphemeralResourceConfig(context.Background(), &tfprotov5.ValidateEphemeralResourceConfigRequest{
+				TypeName: "sendgrid_api_key",
+				Config:   p.dynamicValue(schema, config),
+			})
+			if err != nil {
+				t.Fatalf("ValidateEphemeralResourceConfig() error = %v", err)
+			}
+
+			var summaries []string
+			for _, d := range resp.Diagnostics {
+				summaries = append(summaries, d.Summary)
+			}
+
+			if tt.wantErr == "" && len(summaries) > 0 {
+				t.Errorf("ValidateEphemeralResourceConfig() diagnostics = %v, want none", summaries)
+			}
+
+			if tt.wantErr != "" && (len(summaries) != 1 || summaries[0] != tt.wantErr) {
+				t.Errorf("ValidateEphemeralResourceConfig() diagnostics = %v, want %q", summaries, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,8 +1,16 @@
 package sendgrid
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"time"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var (
@@ -13,6 +21,14 @@ var (
 	// doesn't have the good format.
 	ErrInvalidImportFormat = errors.New("invalid import. Supported import format: {{templateID}}/{{templateVersionID}}")
 
+	// ErrInvalidIPPoolAssignmentImportFormat error displayed when the string passed to import an IP pool
+	// assignment doesn't have the good format.
+	ErrInvalidIPPoolAssignmentImportFormat = errors.New("invalid import. Supported import format: {{poolName}}/{{ip}}")
+
+	// ErrInvalidSingletonImportID error displayed when a setting is imported with another ID than
+	// "default", or the subuser of the provider.
+	ErrInvalidSingletonImportID = errors.New("invalid import. A setting is imported with \"default\", or the subuser name when the provider sets a subuser")
+
 	// ErrSubUserNotFound error displayed when the subUser can not be found.
 	ErrSubUserNotFound = errors.New("subUser wasn't found")
 
@@ -74,8 +90,72 @@ var (
 	// ErrSetUnsubscribeGroupUnsuscribes error displayed when the provider
 	// can't set the unsubscribe group unsubscribes attribute.
 	ErrSetUnsubscribeGroupUnsuscribes = errors.New("could not set unsubscribe group unsubscribes attribute")
+
+	// ErrUpgradeStateNotConfigured error displayed when a state is upgraded without a configured provider.
+	ErrUpgradeStateNotConfigured = errors.New("could not upgrade the state: the provider is not configured")
+
+	// ErrUpgradeStateMissing error displayed when there is no state to upgrade.
+	ErrUpgradeStateMissing = errors.New("could not upgrade the state: no prior state")
 )
 
 func subUserNotFound(name string) error {
-	return fmt.Errorf("%w: %s", ErrSubUserNotFound, name)
+	return fmt.Errorf("%w: %s (%w)", ErrSubUserNotFound, name, sendgrid.ErrNotFound)
+}
+
+// readDiagnostics handles an error returned while reading a resource:
+// if the object doesn't exist anymore in SendGrid, it's removed from the state
+// so that Terraform plans to re-create it instead of failing.
+func readDiagnostics(ctx context.Context, d *schema.ResourceData, resourceType string, err error) diag.Diagnostics {
+	if errors.Is(err, sendgrid.ErrNotFound) {
+		tflog.Warn(ctx, "Resource not found in SendGrid, removing it from the state", notFoundFields(resourceType, d.Id(), err))
+		d.SetId("")
+
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  err.Error(),
+		Detail:   rateLimitDetail(err),
+	}}
+}
+
+// notFoundFields returns the log fields of a resource SendGrid no longer finds.
+func notFoundFields(resourceType, id string, err error) map[string]interface{} {
+	fields := map[string]interface{}{
+		"resource_type": resourceType,
+		"id":            id,
+		"error":         err.Error(),
+	}
+
+	var apiErr *sendgrid.APIError
+	if errors.As(err, &apiErr) && apiErr.RequestID != "" {
+		fields["request_id"] = apiErr.RequestID
+	}
+
+	return fields
+}
+
+// rateLimitDetail tells when the rate limit of the endpoint resets, if SendGrid rate limited the request.
+func rateLimitDetail(err error) string {
+	var apiErr *sendgrid.APIError
+	if !errors.Is(err, sendgrid.ErrRateLimited) || !errors.As(err, &apiErr) || apiErr.Rate.Reset.IsZero() {
+		return ""
+	}
+
+	return fmt.Sprintf("SendGrid allows %d requests per window on this endpoint, and the window resets at %s. "+
+		"Run Terraform again after it.", apiErr.Rate.Limit, apiErr.Rate.Reset.Format(time.RFC3339))
+}
+
+// readNotFound handles an error returned while reading a framework resource,
+// like readDiagnostics does for the SDK resources.
+func readNotFound(ctx context.Context, resp *resource.ReadResponse, resourceType, id string, err error) {
+	if errors.Is(err, sendgrid.ErrNotFound) {
+		tflog.Warn(ctx, "Resource not found in SendGrid, removing it from the state", notFoundFields(resourceType, id, err))
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.AddError(err.Error(), rateLimitDetail(err))
 }
@@ -0,0 +1,404 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"testing"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// The TestUnit* tests run the resources against an in-process fake SendGrid API,
+// so they need neither a SendGrid account nor TF_ACC, only a terraform binary.
+
+// testUnitLongEmail is a valid address longer than most strings accepted by SendGrid.
+var testUnitLongEmail = "first.last+suppressions@" + strings.Repeat("a", 63) + "." + strings.Repeat("b", 63) + ".example.com" //nolint:gochecknoglobals
+
+// testUnitPreCheck skips the test when no terraform binary is available.
+func testUnitPreCheck(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("terraform must be in PATH, or TF_ACC_TERRAFORM_PATH set, for the TestUnit tests")
+	}
+}
+
+// testUnitProviderConfig points the provider at the fake API, with short retry delays.
+// It configures testAccProvider, so that the check functions of the acceptance tests work.
+func testUnitProviderConfig(server *sendgridtest.Server) string {
+	return fmt.Sprintf(`
+provider "sendgrid" {
+	api_key          = "%s"
+	host             = "%s"
+	retry_base_delay = "10ms"
+	retry_max_delay  = "100ms"
+}
+`, sendgridtest.APIKey, server.URL)
+}
+
+func TestUnitSendgridUnsubscribeGroup(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	name := "terraform-" + acctest.RandString(10)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridUnsubscribeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + testAccCheckSendgridUnsubscribeGroupConfigBasic(name, "created"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridUnsubscribeGroupExists("sendgrid_unsubscribe_group.test"),
+					resource.TestCheckResourceAttr("sendgrid_unsubscribe_group.test", "name", name),
+					resource.TestCheckResourceAttr("sendgrid_unsubscribe_group.test", "description", "created"),
+				),
+			},
+			{
+				Config: testUnitProviderConfig(server) + testAccCheckSendgridUnsubscribeGroupConfigBasic(name, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_unsubscribe_group.test", "description", "updated"),
+				),
+			},
+			{
+				// The group was deleted outside of Terraform: it is planned for creation again.
+				PreConfig: func() {
+					groups, err := server.Client().ReadUnsubscribeGroups(context.Background())
+					if err.Err != nil {
+						t.Fatalf("failed listing unsubscribe groups: %v", err.Err)
+					}
+
+					for _, group := range groups {
+						testUnitDelete(t, server, fmt.Sprintf("/asm/groups/%d", group.ID))
+					}
+				},
+				Config:             testUnitProviderConfig(server) + testAccCheckSendgridUnsubscribeGroupConfigBasic(name, "updated"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestUnitSendgridUnsubscribeGroupRateLimited(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	// Creations are only retried on rate limiting, reads on server errors too.
+	server.RateLimitBurst("/asm/groups", 2)
+	server.AddFault(sendgridtest.Fault{Method: http.MethodGet, PathPrefix: "/asm/groups", StatusCode: http.StatusBadGateway, Count: 1})
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridUnsubscribeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + testAccCheckSendgridUnsubscribeGroupConfigBasic("rate-limited", "created"),
+				Check:  testAccCheckSendgridUnsubscribeGroupExists("sendgrid_unsubscribe_group.test"),
+			},
+		},
+	})
+}
+
+func TestUnitSendgridUnsubscribeGroupServerError(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	server.ServerErrors("/asm/groups", http.StatusInternalServerError, 0)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testUnitProviderConfig(server) + testAccCheckSendgridUnsubscribeGroupConfigBasic("failing", "created"),
+				ExpectError: regexp.MustCompile("HTTP 500"),
+			},
+		},
+	})
+}
+
+func TestUnitSendgridAPIKey(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	name := "terraform-api-key-" + acctest.RandString(10)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + testUnitSendgridAPIKeyConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridAPIKeyExists("sendgrid_api_key.test"),
+					resource.TestCheckResourceAttr("sendgrid_api_key.test", "name", name),
+					resource.TestCheckResourceAttrSet("sendgrid_api_key.test", "api_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitSendgridAPIKeyRotation(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	name := "terraform-api-key-" + acctest.RandString(10)
+
+	var firstID, firstKey string
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testUnitCheckNoAPIKeys(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + testUnitSendgridAPIKeyRotationConfig(name, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sendgrid_api_key.test", "rotated_at"),
+					resource.TestCheckNoResourceAttr("sendgrid_api_key.test", "previous_api_key_id"),
+					func(s *terraform.State) error {
+						attributes := s.RootModule().Resources["sendgrid_api_key.test"].Primary.Attributes
+						firstID, firstKey = attributes["id"], attributes["api_key"]
+
+						return nil
+					},
+				),
+			},
+			{
+				// The new key is created, and the previous one kept until the end of its grace period.
+				// It's over right away, so the next plan revokes it.
+				Config: testUnitProviderConfig(server) + testUnitSendgridAPIKeyRotationConfig(name, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						attributes := s.RootModule().Resources["sendgrid_api_key.test"].Primary.Attributes
+						if attributes["current_api_key_id"] == firstID || attributes["api_key"] == firstKey {
+							return fmt.Errorf("the key wasn't rotated: %s", attributes["current_api_key_id"])
+						}
+
+						if attributes["id"] != firstID {
+							return fmt.Errorf("id = %s after a rotation, want it to stay %s", attributes["id"], firstID)
+						}
+
+						if attributes["previous_api_key_id"] != firstID || attributes["previous_api_key"] != firstKey {
+							return fmt.Errorf("previous key = %s, want %s", attributes["previous_api_key_id"], firstID)
+						}
+
+						return nil
+					},
+					testUnitCheckAPIKeyCount(server, 2),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testUnitProviderConfig(server) + testUnitSendgridAPIKeyRotationConfig(name, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_api_key.test", "previous_api_key_id", ""),
+					resource.TestCheckResourceAttr("sendgrid_api_key.test", "previous_api_key", ""),
+					testUnitCheckAPIKeyCount(server, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitSendgridTemplateVersion(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testUnitCheckSendgridTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + testUnitSendgridTemplateVersionConfig("Welcome"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridTemplateExists("sendgrid_template.test"),
+					resource.TestCheckResourceAttrPair(
+						"sendgrid_template_version.test", "template_id", "sendgrid_template.test", "id",
+					),
+					resource.TestCheckResourceAttr("sendgrid_template_version.test", "subject", "Welcome"),
+					resource.TestCheckResourceAttr("sendgrid_template_version.test", "active", "1"),
+				),
+			},
+			{
+				Config: testUnitProviderConfig(server) + testUnitSendgridTemplateVersionConfig("Welcome aboard"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_template_version.test", "subject", "Welcome aboard"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitSendgridTeammatePending(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	email := "terraform-teammate-" + acctest.RandString(10) + "@example.com"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridTeammateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + testAccCheckSendgridTeammateConfigBasic(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridTeammateExists("sendgrid_teammate.test"),
+					resource.TestCheckResourceAttr("sendgrid_teammate.test", "email", email),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitSendgridSubuser(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	username := "terraform-subuser-" + acctest.RandString(10)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testUnitCheckSendgridSubuserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) +
+					testAccCheckSendgridSubuserConfigWithIps(username, username+"@example.com", "TerraformTest123!"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridSubuserExists("sendgrid_subuser.ips"),
+					resource.TestCheckResourceAttr("sendgrid_subuser.ips", "ips.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testUnitSendgridAPIKeyConfig(name string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_api_key" "test" {
+	name   = "%s"
+	scopes = ["mail.send", "sender_verification_eligible"]
+}
+`, name)
+}
+
+func testUnitSendgridAPIKeyRotationConfig(name, trigger string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_api_key" "test" {
+	name   = "%s"
+	scopes = ["mail.send", "sender_verification_eligible"]
+
+	rotation {
+		rotation_trigger = "%s"
+		grace_period     = "0s"
+	}
+}
+`, name, trigger)
+}
+
+// testUnitCheckAPIKeyCount checks how many API keys exist in the fake API.
+func testUnitCheckAPIKeyCount(server *sendgridtest.Server, want int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		apiKeys, err := server.Client().ReadAPIKeys(context.Background())
+		if err.Err != nil {
+			return err.Err
+		}
+
+		if len(apiKeys) != want {
+			return fmt.Errorf("%d API keys exist, want %d", len(apiKeys), want)
+		}
+
+		return nil
+	}
+}
+
+// testUnitCheckNoAPIKeys checks that no API key is left behind, e.g. the previous key of a rotation.
+func testUnitCheckNoAPIKeys(server *sendgridtest.Server) resource.TestCheckFunc {
+	return testUnitCheckAPIKeyCount(server, 0)
+}
+
+func testUnitSendgridTemplateVersionConfig(subject string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_template" "test" {
+	name       = "terraform-template"
+	generation = "dynamic"
+}
+
+resource "sendgrid_template_version" "test" {
+	template_id  = sendgrid_template.test.id
+	name         = "terraform-template-version"
+	subject      = "%s"
+	html_content = "<p>Hello</p>"
+	active       = 1
+}
+`, subject)
+}
+
+func testUnitCheckSendgridTemplateDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_template" {
+			continue
+		}
+
+		if _, err := c.ReadTemplate(context.Background(), rs.Primary.ID); err.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("template still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+// testUnitCheckSendgridSubuserDestroy checks the subusers are gone:
+// SendGrid answers the search of a deleted subuser with an empty list.
+func testUnitCheckSendgridSubuserDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_subuser" {
+			continue
+		}
+
+		subusers, err := c.ReadSubUser(context.Background(), rs.Primary.ID)
+		if err.Err != nil {
+			return err.Err
+		}
+
+		if len(subusers) > 0 {
+			return fmt.Errorf("subuser still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+// testUnitDelete deletes an object of the fake API behind the back of Terraform.
+func testUnitDelete(t *testing.T, server *sendgridtest.Server, path string) {
+	t.Helper()
+
+	if _, err := server.Client().Get(context.Background(), http.MethodDelete, path); err != nil {
+		t.Fatalf("failed deleting %s: %v", path, err)
+	}
+}
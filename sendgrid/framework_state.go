@@ -0,0 +1,168 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// An optional argument missing from the configuration must be null in the state
+// of a framework resource, or Terraform plans to change it. The SDK provider stored
+// whatever SendGrid returned instead, e.g. "" or [], and DiffSuppressFuncs hid it.
+//
+// So the framework resources record in their private state which optional arguments
+// are configured, and only read those from SendGrid. The states written by the SDK
+// provider don't have this record: the arguments with a non-empty value are assumed
+// to be configured.
+const configuredArgumentsKey = "configured_arguments"
+
+// configuredArguments is the set of the optional arguments set in the configuration.
+type configuredArguments map[string]bool
+
+// privateGetter is the private state of a request.
+type privateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// privateSetter is the private state of a response.
+type privateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// configuredArgumentsOf returns the arguments whose planned value is not null.
+func configuredArgumentsOf(arguments map[string]attr.Value) configuredArguments {
+	configured := configuredArguments{}
+
+	for name, value := range arguments {
+		if !value.IsNull() {
+			configured[name] = true
+		}
+	}
+
+	return configured
+}
+
+// allConfigured returns the arguments as if they were all configured, e.g. on import
+// where the whole object is read from SendGrid.
+func allConfigured(arguments map[string]attr.Value) configuredArguments {
+	configured := configuredArguments{}
+
+	for name := range arguments {
+		configured[name] = true
+	}
+
+	return configured
+}
+
+// readConfiguredArguments returns the configured arguments recorded in the private state,
+// or guesses them from the state written by the SDK provider.
+func readConfiguredArguments(
+	ctx context.Context,
+	private privateGetter,
+	arguments map[string]attr.Value,
+) (configuredArguments, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, configuredArgumentsKey)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if data == nil {
+		configured := configuredArguments{}
+
+		for name, value := range arguments {
+			if !isEmptyValue(value) {
+				configured[name] = true
+			}
+		}
+
+		return configured, diags
+	}
+
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		diags.AddError("Invalid private state", "could not decode the configured arguments: "+err.Error())
+
+		return nil, diags
+	}
+
+	configured := configuredArguments{}
+	for _, name := range names {
+		configured[name] = true
+	}
+
+	return configured, diags
+}
+
+// save records the configured arguments in the private state.
+func (c configuredArguments) save(ctx context.Context, private privateSetter) diag.Diagnostics {
+	names := make([]string, 0, len(c))
+	for name := range c {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	data, err := json.Marshal(names)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Invalid private state", "could not encode the configured arguments: "+err.Error())
+
+		return diags
+	}
+
+	return private.SetKey(ctx, configuredArgumentsKey, data)
+}
+
+// string returns the value read from SendGrid for the argument, or null if it isn't configured.
+func (c configuredArguments) string(name, value string) types.String {
+	if !c[name] {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// int64 returns the value read from SendGrid for the argument, or null if it isn't configured.
+func (c configuredArguments) int64(name string, value int64) types.Int64 {
+	if !c[name] {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(value)
+}
+
+// stringSet returns the values read from SendGrid for the argument, or null if it isn't configured.
+func (c configuredArguments) stringSet(name string, values []string) (types.Set, diag.Diagnostics) {
+	if !c[name] {
+		return types.SetNull(types.StringType), nil
+	}
+
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+
+	return types.SetValue(types.StringType, elements)
+}
+
+// isEmptyValue returns true for null, unknown and zero values, e.g. "", 0 or [].
+func isEmptyValue(value attr.Value) bool {
+	if value.IsNull() || value.IsUnknown() {
+		return true
+	}
+
+	switch v := value.(type) {
+	case types.String:
+		return v.ValueString() == ""
+	case types.Int64:
+		return v.ValueInt64() == 0
+	case types.Set:
+		return len(v.Elements()) == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,581 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// The resources migrated from the SDK provider to the plugin framework must keep
+// their schema and accept the states written by the SDK provider without planning
+// any change. testdata/schemas holds the schemas of the SDK resources, and
+// testdata/state the states they wrote for a configuration, once refreshed.
+
+// frameworkDefaults are the arguments with a default value: the framework requires them to be computed.
+var frameworkDefaults = map[string][]string{ //nolint:gochecknoglobals
+	"sendgrid_template_version": {"editor", "generate_plain_content"},
+}
+
+type schemaAttribute struct {
+	Type      string `json:"type"`
+	Required  bool   `json:"required,omitempty"`
+	Optional  bool   `json:"optional,omitempty"`
+	Computed  bool   `json:"computed,omitempty"`
+	Sensitive bool   `json:"sensitive,omitempty"`
+}
+
+type schemaBlock struct {
+	Nesting    string                     `json:"nesting,omitempty"`
+	Attributes map[string]schemaAttribute `json:"attributes"`
+	Blocks     map[string]schemaBlock     `json:"blocks,omitempty"`
+}
+
+type resourceSchema struct {
+	Block   schemaBlock `json:"block"`
+	Version int64       `json:"version"`
+}
+
+// summarizeBlock keeps what matters to the state and the configuration, not the descriptions.
+func summarizeBlock(block *tfprotov5.SchemaBlock) schemaBlock {
+	summary := schemaBlock{Attributes: map[string]schemaAttribute{}}
+
+	for _, a := range block.Attributes {
+		summary.Attributes[a.Name] = schemaAttribute{
+			Type:      a.Type.String(),
+			Required:  a.Required,
+			Optional:  a.Optional,
+			Computed:  a.Computed,
+			Sensitive: a.Sensitive,
+		}
+	}
+
+	for _, b := range block.BlockTypes {
+		if summary.Blocks == nil {
+			summary.Blocks = map[string]schemaBlock{}
+		}
+
+		nested := summarizeBlock(b.Block)
+		nested.Nesting = b.Nesting.String()
+		summary.Blocks[b.TypeName] = nested
+	}
+
+	return summary
+}
+
+// stateFixture is a state written by the SDK provider for a configuration.
+type stateFixture struct {
+	ResourceType  string                 `json:"resource_type"`
+	SchemaVersion int64                  `json:"schema_version"`
+	Config        map[string]interface{} `json:"config"`
+	State         map[string]interface{} `json:"state"`
+}
+
+// testProvider is the muxed provider server, configured against a SendGrid API.
+type testProvider struct {
+	t       *testing.T
+	server  tfprotov5.ProviderServer
+	schemas map[string]*tfprotov5.Schema
+}
+
+func newTestProvider(t *testing.T, host string) *testProvider {
+	t.Helper()
+
+	ctx := context.Background()
+
+	serverFactory, err := ProviderServerFactory(ctx, Provider())
+	if err != nil {
+		t.Fatalf("ProviderServerFactory() error = %v", err)
+	}
+
+	p := &testProvider{t: t, server: serverFactory()}
+
+	schemaResp, err := p.server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema() error = %v", err)
+	}
+
+	p.checkDiagnostics("GetProviderSchema", schemaResp.Diagnostics)
+	p.schemas = schemaResp.ResourceSchemas
+
+	config := p.dynamicValue(schemaResp.Provider, map[string]interface{}{
+		"api_key":     sendgridtest.APIKey,
+		"host":        host,
+		"max_retries": 0,
+	})
+
+	configResp, err := p.server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: config})
+	if err != nil {
+		t.Fatalf("ConfigureProvider() error = %v", err)
+	}
+
+	p.checkDiagnostics("ConfigureProvider", configResp.Diagnostics)
+
+	return p
+}
+
+func (p *testProvider) checkDiagnostics(rpc string, diags []*tfprotov5.Diagnostic) {
+	p.t.Helper()
+
+	for _, d := range diags {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			p.t.Fatalf("%s() error: %s: %s", rpc, d.Summary, d.Detail)
+		}
+	}
+}
+
+// dynamicValue encodes the attributes, the missing ones being null.
+func (p *testProvider) dynamicValue(schema *tfprotov5.Schema, attributes map[string]interface{}) *tfprotov5.DynamicValue {
+	p.t.Helper()
+
+	value := p.value(schema, attributes)
+
+	dv, err := tfprotov5.NewDynamicValue(schema.ValueType(), value)
+	if err != nil {
+		p.t.Fatalf("NewDynamicValue() error = %v", err)
+	}
+
+	return &dv
+}
+
+func (p *testProvider) value(schema *tfprotov5.Schema, attributes map[string]interface{}) tftypes.Value {
+	p.t.Helper()
+
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		p.t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	value, err := (&tfprotov5.RawState{JSON: data}).Unmarshal(schema.ValueType())
+	if err != nil {
+		p.t.Fatalf("decoding %s: %v", data, err)
+	}
+
+	return value
+}
+
+func (p *testProvider) decode(schema *tfprotov5.Schema, dv *tfprotov5.DynamicValue) tftypes.Value {
+	p.t.Helper()
+
+	value, err := dv.Unmarshal(schema.ValueType())
+	if err != nil {
+		p.t.Fatalf("DynamicValue.Unmarshal() error = %v", err)
+	}
+
+	return value
+}
+
+// proposedNewState merges the configuration with the computed attributes of the prior state, like Terraform.
+func proposedNewState(t *testing.T, schema *tfprotov5.Schema, prior, config tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	var priorAttrs, configAttrs map[string]tftypes.Value
+	if err := prior.As(&priorAttrs); err != nil {
+		t.Fatalf("prior state: %v", err)
+	}
+
+	if err := config.As(&configAttrs); err != nil {
+		t.Fatalf("config: %v", err)
+	}
+
+	proposed := map[string]tftypes.Value{}
+	for name, v := range configAttrs {
+		proposed[name] = v
+	}
+
+	for _, a := range schema.Block.Attributes {
+		if a.Computed && configAttrs[a.Name].IsNull() {
+			proposed[a.Name] = priorAttrs[a.Name]
+		}
+	}
+
+	return tftypes.NewValue(schema.ValueType(), proposed)
+}
+
+// applyResource plans and applies a configuration of a resource over a prior state, like Terraform.
+// It returns the planned and the new states, or the diagnostics of the plan when it fails.
+func applyResource(
+	t *testing.T, p *testProvider, typeName string, prior tftypes.Value, config map[string]interface{},
+) (tftypes.Value, tftypes.Value, []*tfprotov5.Diagnostic) {
+	t.Helper()
+
+	ctx := context.Background()
+	schema := p.schemas[typeName]
+	configValue := p.value(schema, config)
+
+	proposed := configValue
+	if !prior.IsNull() {
+		proposed = proposedNewState(t, schema, prior, configValue)
+	}
+
+	priorState, _ := tfprotov5.NewDynamicValue(schema.ValueType(), prior)
+	proposedState, _ := tfprotov5.NewDynamicValue(schema.ValueType(), proposed)
+	configState, _ := tfprotov5.NewDynamicValue(schema.ValueType(), configValue)
+
+	planResp, err := p.server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       &priorState,
+		ProposedNewState: &proposedState,
+		Config:           &configState,
+	})
+	if err != nil {
+		t.Fatalf("PlanResourceChange() error = %v", err)
+	}
+
+	for _, d := range planResp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return prior, prior, planResp.Diagnostics
+		}
+	}
+
+	applyResp, err := p.server.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:       typeName,
+		PriorState:     &priorState,
+		PlannedState:   planResp.PlannedState,
+		Config:         &configState,
+		PlannedPrivate: planResp.PlannedPrivate,
+	})
+	if err != nil {
+		t.Fatalf("ApplyResourceChange() error = %v", err)
+	}
+
+	p.checkDiagnostics("ApplyResourceChange", applyResp.Diagnostics)
+
+	return p.decode(schema, planResp.PlannedState), p.decode(schema, applyResp.NewState), nil
+}
+
+func TestFrameworkResources_schema(t *testing.T) {
+	t.Parallel()
+
+	p := newTestProvider(t, "http://localhost")
+
+	files, err := filepath.Glob("testdata/schemas/*.json")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no SDK schemas found: %v", err)
+	}
+
+	for _, file := range files {
+		resourceType := strings.TrimSuffix(filepath.Base(file), ".json")
+
+		t.Run(resourceType, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var want resourceSchema
+			if err := json.Unmarshal(data, &want); err != nil {
+				t.Fatal(err)
+			}
+
+			schema, ok := p.schemas[resourceType]
+			if !ok {
+				t.Fatalf("the provider has no resource %s", resourceType)
+			}
+
+			got := resourceSchema{Block: summarizeBlock(schema.Block), Version: schema.Version}
+			for _, name := range frameworkDefaults[resourceType] {
+				attribute := got.Block.Attributes[name]
+				attribute.Computed = false
+				got.Block.Attributes[name] = attribute
+			}
+
+			if !reflect.DeepEqual(got, want) {
+				gotJSON, _ := json.MarshalIndent(got, "", "  ")
+				t.Errorf("schema of %s =\n%s\nwant the schema of the SDK resource:\n%s", resourceType, gotJSON, data)
+			}
+		})
+	}
+}
+
+func TestFrameworkResources_stateWrittenBySDK(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	files, err := filepath.Glob("testdata/state/*.json")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no SDK states found: %v", err)
+	}
+
+	for _, file := range files {
+		t.Run(strings.TrimSuffix(filepath.Base(file), ".json"), func(t *testing.T) {
+			t.Parallel()
+
+			server := sendgridtest.NewServer()
+			defer server.Close()
+
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The fixture refers to the IDs of the objects created in the fake API.
+			for placeholder, value := range createFixtureObjects(t, server, file) {
+				data = []byte(strings.ReplaceAll(string(data), "${"+placeholder+"}", value))
+			}
+
+			var fixture stateFixture
+			if err := json.Unmarshal(data, &fixture); err != nil {
+				t.Fatal(err)
+			}
+
+			ctx := context.Background()
+			p := newTestProvider(t, server.URL)
+			schema := p.schemas[fixture.ResourceType]
+
+			stateJSON, err := json.Marshal(fixture.State)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			upgradeResp, err := p.server.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+				TypeName: fixture.ResourceType,
+				Version:  fixture.SchemaVersion,
+				RawState: &tfprotov5.RawState{JSON: stateJSON},
+			})
+			if err != nil {
+				t.Fatalf("UpgradeResourceState() error = %v", err)
+			}
+
+			p.checkDiagnostics("UpgradeResourceState", upgradeResp.Diagnostics)
+
+			readResp, err := p.server.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+				TypeName:     fixture.ResourceType,
+				CurrentState: upgradeResp.UpgradedState,
+			})
+			if err != nil {
+				t.Fatalf("ReadResource() error = %v", err)
+			}
+
+			p.checkDiagnostics("ReadResource", readResp.Diagnostics)
+
+			read := p.decode(schema, readResp.NewState)
+			checkStateMatchesSDK(t, read, p.value(schema, fixture.State))
+
+			config := p.value(schema, fixture.Config)
+
+			proposed, err := tfprotov5.NewDynamicValue(schema.ValueType(), proposedNewState(t, schema, read, config))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			planResp, err := p.server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+				TypeName:         fixture.ResourceType,
+				PriorState:       readResp.NewState,
+				ProposedNewState: &proposed,
+				Config:           p.dynamicValue(schema, fixture.Config),
+				PriorPrivate:     readResp.Private,
+			})
+			if err != nil {
+				t.Fatalf("PlanResourceChange() error = %v", err)
+			}
+
+			p.checkDiagnostics("PlanResourceChange", planResp.Diagnostics)
+
+			if planned := p.decode(schema, planResp.PlannedState); !planned.Equal(read) {
+				diffs, _ := read.Diff(planned)
+				t.Errorf("PlanResourceChange() planned changes: %v", diffs)
+			}
+
+			if len(planResp.RequiresReplace) > 0 {
+				t.Errorf("PlanResourceChange() requires replacement: %v", planResp.RequiresReplace)
+			}
+		})
+	}
+}
+
+// checkStateMatchesSDK compares the state read by the framework resource with the state written by the SDK
+// provider: the SDK provider stored zero values, e.g. "", where the framework stores null.
+func checkStateMatchesSDK(t *testing.T, got, want tftypes.Value) {
+	t.Helper()
+
+	var gotAttrs, wantAttrs map[string]tftypes.Value
+	if err := got.As(&gotAttrs); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := want.As(&wantAttrs); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, w := range wantAttrs {
+		g := gotAttrs[name]
+		if g.Equal(w) || (g.IsNull() && isZeroValue(t, w)) {
+			continue
+		}
+
+		t.Errorf("%s = %v, want %v as written by the SDK provider", name, g, w)
+	}
+}
+
+func isZeroValue(t *testing.T, v tftypes.Value) bool {
+	t.Helper()
+
+	if v.IsNull() {
+		return true
+	}
+
+	switch {
+	case v.Type().Is(tftypes.String):
+		var s string
+
+		return v.As(&s) == nil && s == ""
+	case v.Type().Is(tftypes.Number):
+		n := new(big.Float)
+
+		return v.As(&n) == nil && n.Sign() == 0
+	case v.Type().Is(tftypes.Set{}):
+		var elements []tftypes.Value
+
+		return v.As(&elements) == nil && len(elements) == 0
+	default:
+		return false
+	}
+}
+
+// createFixtureObjects creates in the fake API the object of the state fixture,
+// and returns the values of the placeholders of the fixture.
+func createFixtureObjects(t *testing.T, server *sendgridtest.Server, file string) map[string]string {
+	t.Helper()
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fixture stateFixture
+	if err := json.Unmarshal(data, &fixture); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	client := server.Client()
+	config := fixture.Config
+
+	str := func(name string) string {
+		s, _ := config[name].(string)
+
+		return s
+	}
+
+	switch fixture.ResourceType {
+	case "sendgrid_teammate":
+		var scopes []string
+		for _, s := range fixture.State["scopes"].([]interface{}) {
+			scopes = append(scopes, s.(string))
+		}
+
+		isAdmin, _ := config["is_admin"].(bool)
+
+		var err sendgrid.RequestError
+		if isSSO, _ := config["is_sso"].(bool); isSSO {
+			_, err = client.CreateSSOUser(ctx, str("first_name"), str("last_name"), str("email"), scopes, isAdmin)
+		} else {
+			_, err = client.CreateUser(ctx, str("email"), scopes, isAdmin)
+		}
+
+		if err.Err != nil {
+			t.Fatalf("creating the teammate: %v", err.Err)
+		}
+
+		return nil
+	case "sendgrid_template_version":
+		template, err := client.CreateTemplate(ctx, "terraform-template", "dynamic")
+		if err.Err != nil {
+			t.Fatalf("creating the template: %v", err.Err)
+		}
+
+		generatePlainContent, ok := config["generate_plain_content"].(bool)
+		created, err := client.CreateTemplateVersion(ctx, sendgrid.TemplateVersion{
+			TemplateID:           template.ID,
+			Name:                 str("name"),
+			Subject:              str("subject"),
+			HTMLContent:          str("html_content"),
+			PlainContent:         str("plain_content"),
+			GeneratePlainContent: generatePlainContent || !ok,
+			Editor:               str("editor"),
+			TestData:             str("test_data"),
+		})
+		if err.Err != nil {
+			t.Fatalf("creating the template version: %v", err.Err)
+		}
+
+		return map[string]string{"template_id": template.ID, "id": created.ID, "updated_at": created.UpdatedAt}
+	default:
+		t.Fatalf("no fixture objects for %s", fixture.ResourceType)
+
+		return nil
+	}
+}
+
+func TestFrameworkResources_readNotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		state map[string]interface{}
+	}{
+		{name: "sendgrid_teammate", state: map[string]interface{}{"id": "someone@example.com"}},
+		{name: "sendgrid_template_version", state: map[string]interface{}{"id": "version-id", "template_id": "d-123"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, api := range []struct {
+				name    string
+				handler http.HandlerFunc
+				gone    bool
+			}{
+				{name: "gone", handler: goneAPI, gone: true},
+				{name: "broken", handler: brokenAPI, gone: false},
+			} {
+				server := httptest.NewServer(api.handler)
+				p := newTestProvider(t, server.URL)
+				schema := p.schemas[tt.name]
+
+				resp, err := p.server.ReadResource(context.Background(), &tfprotov5.ReadResourceRequest{
+					TypeName:     tt.name,
+					CurrentState: p.dynamicValue(schema, tt.state),
+				})
+
+				server.Close()
+
+				if err != nil {
+					t.Fatalf("%s API: ReadResource() error = %v", api.name, err)
+				}
+
+				if api.gone {
+					for _, d := range resp.Diagnostics {
+						t.Errorf("%s API: ReadResource() diagnostic = %s, want none", api.name, d.Summary)
+					}
+
+					if !p.decode(schema, resp.NewState).IsNull() {
+						t.Errorf("%s API: ReadResource() kept the resource, want it removed from the state", api.name)
+					}
+
+					continue
+				}
+
+				if len(resp.Diagnostics) == 0 {
+					t.Errorf("%s API: ReadResource() expected an error", api.name)
+				} else if !strings.Contains(resp.Diagnostics[0].Summary, "internal error") {
+					t.Errorf("%s API: ReadResource() error = %q, want the API error", api.name, resp.Diagnostics[0].Summary)
+				}
+			}
+		})
+	}
+}
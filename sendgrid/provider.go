@@ -27,9 +27,34 @@ Template Resources
 	sendgrid_template
 	sendgrid_template_version
 
-Unsubscribe Group Resource
+Unsubscribe Group Resources
 
 	sendgrid_unsubscribe_group
+	sendgrid_unsubscribe_group_suppressions
+	sendgrid_global_suppression
+	sendgrid_suppression_removal
+
+Mail Settings Resources
+
+	sendgrid_mail_settings_address_allowlist
+	sendgrid_mail_settings_bcc
+	sendgrid_mail_settings_bounce_purge
+	sendgrid_mail_settings_footer
+	sendgrid_mail_settings_forward_bounce
+	sendgrid_mail_settings_forward_spam
+	sendgrid_mail_settings_template
+
+Tracking Settings Resources
+
+	sendgrid_tracking_settings_click
+	sendgrid_tracking_settings_google_analytics
+	sendgrid_tracking_settings_open
+	sendgrid_tracking_settings_subscription
+
+Account Settings Resources
+
+	sendgrid_enforced_tls
+	sendgrid_partner_settings_new_relic
 
 WebHook Resources
 
@@ -40,15 +65,28 @@ package sendgrid
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
 	maxStringLength        = 100
+	maxEmailLength         = 254 // the longest address SMTP accepts
 	unsubscribeGroupLength = 30
+	ipPoolNameLength       = 64
+	defaultMaxRetries      = 3
+	defaultRetryJitter     = 0.2
+
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 10 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 20 * time.Minute
 )
 
 // Provider terraform.ResourceProvider.
@@ -71,6 +109,51 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("SENDGRID_SUBUSER", nil),
 			},
+			"max_retries": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  defaultMaxRetries,
+				Description: "Number of times a request failing for a transient reason is retried. 0 disables these retries, " +
+					"but a rate limited request is still retried until the timeout of the operation.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_base_delay": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "1s",
+				Description:      "Delay before the first retry, doubled on each subsequent retry, e.g. `500ms`.",
+				ValidateDiagFunc: validateDuration,
+			},
+			"retry_max_delay": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "30s",
+				Description:      "Maximum delay between two retries, e.g. `1m`.",
+				ValidateDiagFunc: validateDuration,
+			},
+			"retry_jitter": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      defaultRetryJitter,
+				Description:  "Fraction, between 0 and 1, of each retry delay that is randomized.",
+				ValidateFunc: validation.FloatBetween(0, 1),
+			},
+			"retry_status_codes": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "HTTP status codes that are retried. Defaults to 429, 500, 502, 503 and 504, an empty set retries none.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(400, 599), //nolint:mnd
+				},
+			},
+			"retry_network_errors": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "Whether requests that got no response, e.g. on a connection reset, are retried. " +
+					"Creations are only retried when the connection could not be established.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -78,21 +161,48 @@ func Provider() *schema.Provider {
 			"sendgrid_template_version":  dataSendgridTemplateVersion(),
 			"sendgrid_unsubscribe_group": dataSendgridUnsubscribeGroup(),
 			"sendgrid_teammate":          dataSendgridTeammate(),
+			"sendgrid_ips":               dataSendgridIPs(),
+			"sendgrid_verified_senders":  dataSendgridVerifiedSenders(),
+			"sendgrid_bounces":           dataSendgridSuppressionList(sendgrid.SuppressionBounces),
+			"sendgrid_blocks":            dataSendgridSuppressionList(sendgrid.SuppressionBlocks),
+			"sendgrid_spam_reports":      dataSendgridSuppressionList(sendgrid.SuppressionSpamReports),
+			"sendgrid_invalid_emails":    dataSendgridSuppressionList(sendgrid.SuppressionInvalidEmails),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"sendgrid_api_key":               resourceSendgridAPIKey(),
-			"sendgrid_subuser":               resourceSendgridSubuser(),
-			"sendgrid_template":              resourceSendgridTemplate(),
-			"sendgrid_template_version":      resourceSendgridTemplateVersion(),
-			"sendgrid_unsubscribe_group":     resourceSendgridUnsubscribeGroup(),
-			"sendgrid_parse_webhook":         resourceSendgridParseWebhook(),
-			"sendgrid_event_webhook":         resourceSendgridEventWebhook(),
-			"sendgrid_domain_authentication": resourceSendgridDomainAuthentication(),
-			"sendgrid_link_branding":         resourceSendgridLinkBranding(),
-			"sendgrid_sso_integration":       resourceSendgridSSOIntegration(),
-			"sendgrid_sso_certificate":       resourceSendgridSSOCertificate(),
-			"sendgrid_teammate":              resourceSendgridTeammate(),
+			"sendgrid_api_key":                            resourceSendgridAPIKey(),
+			"sendgrid_subuser":                            resourceSendgridSubuser(),
+			"sendgrid_template":                           resourceSendgridTemplate(),
+			"sendgrid_unsubscribe_group":                  resourceSendgridUnsubscribeGroup(),
+			"sendgrid_unsubscribe_group_suppressions":     resourceSendgridUnsubscribeGroupSuppressions(),
+			"sendgrid_global_suppression":                 resourceSendgridGlobalSuppression(),
+			"sendgrid_suppression_removal":                resourceSendgridSuppressionRemoval(),
+			"sendgrid_parse_webhook":                      resourceSendgridParseWebhook(),
+			"sendgrid_event_webhook":                      resourceSendgridEventWebhook(),
+			"sendgrid_mail_settings_footer":               resourceSendgridMailSettingsFooter(),
+			"sendgrid_mail_settings_bcc":                  resourceSendgridMailSettingsBCC(),
+			"sendgrid_mail_settings_bounce_purge":         resourceSendgridMailSettingsBouncePurge(),
+			"sendgrid_mail_settings_forward_bounce":       resourceSendgridMailSettingsForwardBounce(),
+			"sendgrid_mail_settings_forward_spam":         resourceSendgridMailSettingsForwardSpam(),
+			"sendgrid_mail_settings_address_allowlist":    resourceSendgridMailSettingsAddressAllowlist(),
+			"sendgrid_mail_settings_template":             resourceSendgridMailSettingsTemplate(),
+			"sendgrid_tracking_settings_click":            resourceSendgridTrackingSettingsClick(),
+			"sendgrid_tracking_settings_open":             resourceSendgridTrackingSettingsOpen(),
+			"sendgrid_tracking_settings_subscription":     resourceSendgridTrackingSettingsSubscription(),
+			"sendgrid_tracking_settings_google_analytics": resourceSendgridTrackingSettingsGoogleAnalytics(),
+			"sendgrid_enforced_tls":                       resourceSendgridEnforcedTLS(),
+			"sendgrid_partner_settings_new_relic":         resourceSendgridPartnerSettingsNewRelic(),
+			"sendgrid_domain_authentication":              resourceSendgridDomainAuthentication(),
+			"sendgrid_domain_authentication_validation":   resourceSendgridDomainAuthenticationValidation(),
+			"sendgrid_link_branding":                      resourceSendgridLinkBranding(),
+			"sendgrid_link_branding_validation":           resourceSendgridLinkBrandingValidation(),
+			"sendgrid_reverse_dns":                        resourceSendgridReverseDNS(),
+			"sendgrid_sso_integration":                    resourceSendgridSSOIntegration(),
+			"sendgrid_sso_certificate":                    resourceSendgridSSOCertificate(),
+			"sendgrid_ip_pool":                            resourceSendgridIPPool(),
+			"sendgrid_ip_pool_assignment":                 resourceSendgridIPPoolAssignment(),
+			"sendgrid_ip_warmup":                          resourceSendgridIPWarmup(),
+			"sendgrid_verified_sender":                    resourceSendgridVerifiedSender(),
 		},
 
 		ConfigureContextFunc: providerConfigure,
@@ -118,5 +228,88 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 	host := d.Get("host").(string)
 	subuser := d.Get("subuser").(string)
 
-	return sendgrid.NewClient(apiKey, host, subuser), diags
+	retryPolicy, err := retryPolicyFromConfig(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return sendgrid.NewClient(apiKey, host, subuser, sendgrid.WithRetryPolicy(retryPolicy)), diags
+}
+
+// retryPolicyFromConfig builds the retry policy of the client from the provider arguments.
+func retryPolicyFromConfig(d *schema.ResourceData) (sendgrid.RetryPolicy, error) {
+	policy := sendgrid.DefaultRetryPolicy()
+	policy.MaxAttempts = d.Get("max_retries").(int) + 1
+	policy.Jitter = d.Get("retry_jitter").(float64)
+	policy.RetryNetworkErrors = d.Get("retry_network_errors").(bool)
+
+	baseDelay, err := time.ParseDuration(d.Get("retry_base_delay").(string))
+	if err != nil {
+		return policy, fmt.Errorf("invalid retry_base_delay: %w", err)
+	}
+
+	maxDelay, err := time.ParseDuration(d.Get("retry_max_delay").(string))
+	if err != nil {
+		return policy, fmt.Errorf("invalid retry_max_delay: %w", err)
+	}
+
+	if maxDelay < baseDelay {
+		return policy, fmt.Errorf("retry_max_delay (%s) must not be lower than retry_base_delay (%s)", maxDelay, baseDelay)
+	}
+
+	policy.BaseDelay = baseDelay
+	policy.MaxDelay = maxDelay
+
+	// An empty retry_status_codes disables the retries on status codes, only an unset one keeps the defaults.
+	if codes := d.Get("retry_status_codes").(*schema.Set).List(); len(codes) > 0 || retryStatusCodesSet(d) {
+		policy.RetryableStatusCodes = make([]int, 0, len(codes))
+		for _, code := range codes {
+			policy.RetryableStatusCodes = append(policy.RetryableStatusCodes, code.(int))
+		}
+	}
+
+	return policy, nil
+}
+
+// retryStatusCodesSet tells whether retry_status_codes is in the configuration, even as an empty set.
+func retryStatusCodesSet(d *schema.ResourceData) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+
+	codes := config.GetAttr("retry_status_codes")
+
+	return codes.IsKnown() && !codes.IsNull()
+}
+
+// validatePositiveDuration validates a duration greater than zero, e.g. the time between two polls.
+func validatePositiveDuration(v interface{}, path cty.Path) diag.Diagnostics {
+	if diags := validateDuration(v, path); diags.HasError() {
+		return diags
+	}
+
+	if d, _ := time.ParseDuration(v.(string)); d <= 0 {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid duration",
+			Detail:        fmt.Sprintf("%q must be greater than zero, e.g. 10s or 1m", v),
+			AttributePath: path,
+		}}
+	}
+
+	return nil
+}
+
+func validateDuration(v interface{}, path cty.Path) diag.Diagnostics {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid duration",
+			Detail:        fmt.Sprintf("%q is not a valid duration, e.g. 500ms, 2s or 1m: %s", v, err),
+			AttributePath: path,
+		}}
+	}
+
+	return nil
 }
@@ -0,0 +1,150 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProviderServerFactory muxes the resources migrated to the plugin framework
+// with the ones still served by the SDK provider, as a single provider server.
+func ProviderServerFactory(ctx context.Context, sdkProvider *schema.Provider) (func() tfprotov5.ProviderServer, error) {
+	servers := []func() tfprotov5.ProviderServer{
+		// The SDK provider comes first: it validates the provider block and
+		// configures the client before the framework provider is configured.
+		sdkProvider.GRPCProvider,
+		providerserver.NewProtocol5(&frameworkProvider{sdkProvider: sdkProvider}),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, servers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
+
+// frameworkProvider serves the resources migrated to the plugin framework.
+// It shares the client configured by the SDK provider, so that both providers
+// use the same settings and rate limiter.
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+}
+
+var (
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+)
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "sendgrid"
+}
+
+// Schema must be identical to the schema of the SDK provider. The defaults,
+// the environment variables and the validation are handled by the SDK provider.
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = providerschema.Schema{
+		Attributes: map[string]providerschema.Attribute{
+			"api_key": providerschema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"host": providerschema.StringAttribute{
+				Optional: true,
+			},
+			"subuser": providerschema.StringAttribute{
+				Optional: true,
+			},
+			"max_retries": providerschema.Int64Attribute{
+				Optional: true,
+				Description: "Number of times a request failing for a transient reason is retried. 0 disables these retries, " +
+					"but a rate limited request is still retried until the timeout of the operation.",
+			},
+			"retry_base_delay": providerschema.StringAttribute{
+				Optional:    true,
+				Description: "Delay before the first retry, doubled on each subsequent retry, e.g. `500ms`.",
+			},
+			"retry_max_delay": providerschema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum delay between two retries, e.g. `1m`.",
+			},
+			"retry_jitter": providerschema.Float64Attribute{
+				Optional:    true,
+				Description: "Fraction, between 0 and 1, of each retry delay that is randomized.",
+			},
+			"retry_status_codes": providerschema.SetAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "HTTP status codes that are retried. Defaults to 429, 500, 502, 503 and 504, an empty set retries none.",
+			},
+			"retry_network_errors": providerschema.BoolAttribute{
+				Optional: true,
+				Description: "Whether requests that got no response, e.g. on a connection reset, are retried. " +
+					"Creations are only retried when the connection could not be established.",
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	client, ok := p.sdkProvider.Meta().(*sendgrid.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Sendgrid client wasn't configured",
+			"The provider must be configured by the SDK provider first. This is a bug in the provider.",
+		)
+
+		return
+	}
+
+	resp.ResourceData = client
+	resp.DataSourceData = client
+	resp.EphemeralResourceData = client
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		newTeammateResource,
+		newTemplateVersionResource,
+	}
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		newAPIKeyEphemeralResource,
+	}
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+// providerClient returns the client configured by the provider for a resource or a data source.
+// It's nil until the provider is configured.
+func providerClient(providerData interface{}, diags *diag.Diagnostics) *sendgrid.Client {
+	if providerData == nil {
+		return nil
+	}
+
+	client, ok := providerData.(*sendgrid.Client)
+	if !ok {
+		diags.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected *sendgrid.Client, got %T. This is a bug in the provider.", providerData),
+		)
+	}
+
+	return client
+}
@@ -0,0 +1,162 @@
+package sendgrid
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+)
+
+func TestRetryPolicyFromConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		raw             map[string]interface{}
+		wantAttempts    int
+		wantBaseDelay   time.Duration
+		wantMaxDelay    time.Duration
+		wantStatusCodes []int
+		wantNetwork     bool
+		wantErr         bool
+	}{
+		{
+			name:            "defaults",
+			raw:             map[string]interface{}{},
+			wantAttempts:    4,
+			wantBaseDelay:   time.Second,
+			wantMaxDelay:    30 * time.Second,
+			wantStatusCodes: []int{429, 500, 502, 503, 504},
+			wantNetwork:     true,
+		},
+		{
+			name: "custom",
+			raw: map[string]interface{}{
+				"max_retries":          0,
+				"retry_base_delay":     "250ms",
+				"retry_max_delay":      "2m",
+				"retry_status_codes":   []interface{}{http.StatusServiceUnavailable},
+				"retry_network_errors": false,
+			},
+			wantAttempts:    1,
+			wantBaseDelay:   250 * time.Millisecond,
+			wantMaxDelay:    2 * time.Minute,
+			wantStatusCodes: []int{http.StatusServiceUnavailable},
+			wantNetwork:     false,
+		},
+		{
+			name:    "invalid delay",
+			raw:     map[string]interface{}{"retry_max_delay": "soon"},
+			wantErr: true,
+		},
+		{
+			name:    "max delay lower than base delay",
+			raw:     map[string]interface{}{"retry_base_delay": "10s", "retry_max_delay": "5s"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tt.raw)
+
+			got, err := retryPolicyFromConfig(d)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("retryPolicyFromConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if got.MaxAttempts != tt.wantAttempts {
+				t.Errorf("MaxAttempts = %d, want %d", got.MaxAttempts, tt.wantAttempts)
+			}
+
+			if got.BaseDelay != tt.wantBaseDelay || got.MaxDelay != tt.wantMaxDelay {
+				t.Errorf("delays = %s/%s, want %s/%s", got.BaseDelay, got.MaxDelay, tt.wantBaseDelay, tt.wantMaxDelay)
+			}
+
+			if !reflect.DeepEqual(got.RetryableStatusCodes, tt.wantStatusCodes) {
+				t.Errorf("RetryableStatusCodes = %v, want %v", got.RetryableStatusCodes, tt.wantStatusCodes)
+			}
+
+			if got.RetryNetworkErrors != tt.wantNetwork {
+				t.Errorf("RetryNetworkErrors = %v, want %v", got.RetryNetworkErrors, tt.wantNetwork)
+			}
+		})
+	}
+}
+
+// TestRetryPolicyFromConfigEmptyStatusCodes configures the provider, since only its
+// configuration tells an empty retry_status_codes from an unset one.
+func TestRetryPolicyFromConfigEmptyStatusCodes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		codes cty.Value
+		want  []int
+	}{
+		{name: "unset", codes: cty.NullVal(cty.Set(cty.Number)), want: []int{429, 500, 502, 503, 504}},
+		{name: "empty", codes: cty.SetValEmpty(cty.Number), want: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := Provider()
+
+			var got sendgrid.RetryPolicy
+
+			p.ConfigureContextFunc = func(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+				policy, err := retryPolicyFromConfig(d)
+				got = policy
+
+				return nil, diag.FromErr(err)
+			}
+
+			configSchema := schema.InternalMap(p.Schema).CoreConfigSchema()
+
+			attrs := map[string]cty.Value{}
+			for name, ty := range configSchema.ImpliedType().AttributeTypes() {
+				attrs[name] = cty.NullVal(ty)
+			}
+
+			attrs["retry_status_codes"] = tt.codes
+
+			// As the plugin server does, keep the configuration for GetRawConfig.
+			config := terraform.NewResourceConfigShimmed(cty.ObjectVal(attrs), configSchema)
+			config.CtyValue = cty.ObjectVal(attrs)
+
+			if diags := p.Configure(context.Background(), config); diags.HasError() {
+				t.Fatalf("Configure() diagnostics = %v", diags)
+			}
+
+			if !reflect.DeepEqual(got.RetryableStatusCodes, tt.want) {
+				t.Errorf("RetryableStatusCodes = %v, want %v", got.RetryableStatusCodes, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidatePositiveDuration(t *testing.T) {
+	t.Parallel()
+
+	for v, wantErr := range map[string]bool{"10s": false, "1m": false, "0s": true, "-5s": true, "soon": true} {
+		if diags := validatePositiveDuration(v, cty.Path{}); diags.HasError() != wantErr {
+			t.Errorf("validatePositiveDuration(%q) = %v, want an error: %v", v, diags, wantErr)
+		}
+	}
+}
@@ -1,21 +1,32 @@
 package sendgrid_test
 
 import (
+	"context"
 	"os"
 	"testing"
 
 	"github.com/arslanbekov/terraform-provider-sendgrid/sendgrid"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-var testAccProviders map[string]*schema.Provider
+// testAccProtoV5ProviderFactories serve the muxed provider. Its SDK provider is
+// testAccProvider, whose client is used by the check functions of the tests.
+var testAccProtoV5ProviderFactories map[string]func() (tfprotov5.ProviderServer, error)
 
 var testAccProvider *schema.Provider
 
 func init() {
 	testAccProvider = sendgrid.Provider()
-	testAccProviders = map[string]*schema.Provider{
-		"sendgrid": testAccProvider,
+	testAccProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+		"sendgrid": func() (tfprotov5.ProviderServer, error) {
+			serverFactory, err := sendgrid.ProviderServerFactory(context.Background(), testAccProvider)
+			if err != nil {
+				return nil, err
+			}
+
+			return serverFactory(), nil
+		},
 	}
 }
 
@@ -29,6 +40,33 @@ func TestProvider_impl(t *testing.T) {
 	_ = sendgrid.Provider()
 }
 
+func TestProviderServerFactory(t *testing.T) {
+	serverFactory, err := sendgrid.ProviderServerFactory(context.Background(), sendgrid.Provider())
+	if err != nil {
+		t.Fatalf("ProviderServerFactory() error = %v", err)
+	}
+
+	// The mux fails when the schemas of the SDK and framework providers differ.
+	resp, err := serverFactory().GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema() error = %v", err)
+	}
+
+	for _, d := range resp.Diagnostics {
+		t.Errorf("GetProviderSchema() diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	for _, name := range []string{"sendgrid_api_key", "sendgrid_teammate", "sendgrid_template_version"} {
+		if _, ok := resp.ResourceSchemas[name]; !ok {
+			t.Errorf("GetProviderSchema() is missing the resource %s", name)
+		}
+	}
+
+	if _, ok := resp.EphemeralResourceSchemas["sendgrid_api_key"]; !ok {
+		t.Errorf("GetProviderSchema() is missing the ephemeral resource sendgrid_api_key")
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	t.Helper()
 
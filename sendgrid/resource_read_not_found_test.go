@@ -0,0 +1,159 @@
+package sendgrid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// goneAPI answers like SendGrid does once every object was deleted outside of Terraform.
+func goneAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case r.URL.Path == "/teammates" || r.URL.Path == "/teammates/pending":
+		_, _ = w.Write([]byte(`{"result":[]}`))
+	case r.URL.Path == "/subusers":
+		_, _ = w.Write([]byte(`[]`))
+	case r.URL.Path == "/verified_senders":
+		_, _ = w.Write([]byte(`{"results":[]}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[{"field":null,"message":"resource not found"}]}`))
+	}
+}
+
+// brokenAPI answers every request with a server error.
+func brokenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusInternalServerError)
+	_, _ = w.Write([]byte(`{"errors":[{"message":"internal error"}]}`))
+}
+
+func TestResourceRead_notFound(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		resource *schema.Resource
+		id       string
+	}{
+		{name: "sendgrid_api_key", resource: resourceSendgridAPIKey(), id: "key-id"},
+		{name: "sendgrid_domain_authentication", resource: resourceSendgridDomainAuthentication(), id: "123"},
+		{name: "sendgrid_domain_authentication_validation", resource: resourceSendgridDomainAuthenticationValidation(), id: "123"},
+		{name: "sendgrid_enforced_tls", resource: resourceSendgridEnforcedTLS(), id: "default"},
+		{name: "sendgrid_event_webhook", resource: resourceSendgridEventWebhook(), id: "webhook"},
+		{name: "sendgrid_ip_pool", resource: resourceSendgridIPPool(), id: "transactional"},
+		{name: "sendgrid_ip_pool_assignment", resource: resourceSendgridIPPoolAssignment(), id: "transactional/192.0.2.10"},
+		{name: "sendgrid_ip_warmup", resource: resourceSendgridIPWarmup(), id: "192.0.2.10"},
+		{name: "sendgrid_link_branding", resource: resourceSendgridLinkBranding(), id: "123"},
+		{name: "sendgrid_link_branding_validation", resource: resourceSendgridLinkBrandingValidation(), id: "123"},
+		{name: "sendgrid_mail_settings_address_allowlist", resource: resourceSendgridMailSettingsAddressAllowlist(), id: "default"},
+		{name: "sendgrid_mail_settings_bcc", resource: resourceSendgridMailSettingsBCC(), id: "default"},
+		{name: "sendgrid_mail_settings_bounce_purge", resource: resourceSendgridMailSettingsBouncePurge(), id: "default"},
+		{name: "sendgrid_mail_settings_footer", resource: resourceSendgridMailSettingsFooter(), id: "default"},
+		{name: "sendgrid_mail_settings_forward_bounce", resource: resourceSendgridMailSettingsForwardBounce(), id: "default"},
+		{name: "sendgrid_mail_settings_forward_spam", resource: resourceSendgridMailSettingsForwardSpam(), id: "default"},
+		{name: "sendgrid_mail_settings_template", resource: resourceSendgridMailSettingsTemplate(), id: "default"},
+		{name: "sendgrid_parse_webhook", resource: resourceSendgridParseWebhook(), id: "parse.example.com"},
+		{name: "sendgrid_partner_settings_new_relic", resource: resourceSendgridPartnerSettingsNewRelic(), id: "default"},
+		{name: "sendgrid_reverse_dns", resource: resourceSendgridReverseDNS(), id: "123"},
+		{name: "sendgrid_sso_certificate", resource: resourceSendgridSSOCertificate(), id: "123"},
+		{name: "sendgrid_sso_integration", resource: resourceSendgridSSOIntegration(), id: "abc"},
+		{name: "sendgrid_subuser", resource: resourceSendgridSubuser(), id: "someone"},
+		{name: "sendgrid_template", resource: resourceSendgridTemplate(), id: "d-123"},
+		{name: "sendgrid_tracking_settings_click", resource: resourceSendgridTrackingSettingsClick(), id: "default"},
+		{name: "sendgrid_tracking_settings_google_analytics", resource: resourceSendgridTrackingSettingsGoogleAnalytics(), id: "default"},
+		{name: "sendgrid_tracking_settings_open", resource: resourceSendgridTrackingSettingsOpen(), id: "default"},
+		{name: "sendgrid_tracking_settings_subscription", resource: resourceSendgridTrackingSettingsSubscription(), id: "default"},
+		{name: "sendgrid_unsubscribe_group", resource: resourceSendgridUnsubscribeGroup(), id: "123"},
+		{name: "sendgrid_unsubscribe_group_suppressions", resource: resourceSendgridUnsubscribeGroupSuppressions(), id: "123"},
+		{name: "sendgrid_verified_sender", resource: resourceSendgridVerifiedSender(), id: "123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, api := range []struct {
+				name    string
+				handler http.HandlerFunc
+				gone    bool
+			}{
+				{name: "gone", handler: goneAPI, gone: true},
+				{name: "broken", handler: brokenAPI, gone: false},
+			} {
+				server := httptest.NewServer(api.handler)
+				client := sendgrid.NewClient("secret", server.URL, "", sendgrid.WithRetryPolicy(sendgrid.RetryPolicy{}))
+
+				d := tt.resource.TestResourceData()
+				d.SetId(tt.id)
+
+				diags := tt.resource.ReadContext(context.Background(), d, client)
+
+				server.Close()
+
+				if api.gone {
+					if diags.HasError() {
+						t.Errorf("%s API: Read() diagnostics = %v, want none", api.name, diags)
+					}
+
+					if d.Id() != "" {
+						t.Errorf("%s API: Read() kept the ID %q, want it removed from the state", api.name, d.Id())
+					}
+
+					continue
+				}
+
+				if !diags.HasError() {
+					t.Errorf("%s API: Read() expected an error", api.name)
+				} else if !strings.Contains(diags[0].Summary, "internal error") {
+					t.Errorf("%s API: Read() error = %q, want the API error", api.name, diags[0].Summary)
+				}
+
+				if d.Id() != tt.id {
+					t.Errorf("%s API: Read() changed the ID to %q, want %q", api.name, d.Id(), tt.id)
+				}
+			}
+		})
+	}
+}
+
+func TestResourceRead_rateLimited(t *testing.T) {
+	t.Parallel()
+
+	reset := time.Now().Add(time.Minute).Truncate(time.Second).UTC()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "600")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := sendgrid.NewClient("secret", server.URL, "", sendgrid.WithRetryPolicy(sendgrid.RetryPolicy{}))
+
+	resource := resourceSendgridTemplate()
+	d := resource.TestResourceData()
+	d.SetId("d-123")
+
+	// SendGrid keeps rate limiting the read until the context is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	diags := resource.ReadContext(ctx, d, client)
+	if !diags.HasError() {
+		t.Fatal("Read() expected an error")
+	}
+
+	if want := "the window resets at " + reset.Format(time.RFC3339); !strings.Contains(diags[0].Detail, want) {
+		t.Errorf("Read() detail = %q, want it to contain %q", diags[0].Detail, want)
+	}
+}
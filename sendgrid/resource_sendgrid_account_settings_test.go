@@ -0,0 +1,154 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitSendgridEnforcedTLS(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	config := testUnitProviderConfig(server) + `
+resource "sendgrid_enforced_tls" "test" {
+  require_tls        = true
+  require_valid_cert = true
+  version            = "1.2"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testUnitCheckEnforcedTLSDisabled,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + `
+resource "sendgrid_enforced_tls" "test" {
+  require_tls = true
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_enforced_tls.test", "id", "default"),
+					resource.TestCheckResourceAttr("sendgrid_enforced_tls.test", "require_valid_cert", "false"),
+					resource.TestCheckResourceAttr("sendgrid_enforced_tls.test", "version", "1.1"),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_enforced_tls.test", "require_valid_cert", "true"),
+					resource.TestCheckResourceAttr("sendgrid_enforced_tls.test", "version", "1.2"),
+				),
+			},
+			{
+				// A change made outside of Terraform is reverted.
+				PreConfig: func() {
+					_, err := server.Client().UpdateEnforcedTLS(context.Background(), sendgrid.EnforcedTLS{
+						RequireTLS: false, RequireValidCert: false, Version: 1.1,
+					})
+					if err.Err != nil {
+						t.Fatalf("failed changing the enforced TLS: %v", err.Err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_enforced_tls.test", "require_tls", "true"),
+					resource.TestCheckResourceAttr("sendgrid_enforced_tls.test", "version", "1.2"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_enforced_tls.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitSendgridPartnerSettingsNewRelic(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	config := testUnitProviderConfig(server) + `
+resource "sendgrid_partner_settings_new_relic" "test" {
+  enabled                   = true
+  license_key               = "0123456789abcdef"
+  enable_subuser_statistics = true
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testUnitCheckNewRelicDisabled,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_partner_settings_new_relic.test", "id", "default"),
+					resource.TestCheckResourceAttr("sendgrid_partner_settings_new_relic.test", "license_key", "0123456789abcdef"),
+				),
+			},
+			{
+				// A change made outside of Terraform is reverted.
+				PreConfig: func() {
+					_, err := server.Client().UpdatePartnerSettingsNewRelic(context.Background(), sendgrid.PartnerSettingsNewRelic{
+						Enabled: true, LicenseKey: "fedcba9876543210",
+					})
+					if err.Err != nil {
+						t.Fatalf("failed changing the New Relic setting: %v", err.Err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_partner_settings_new_relic.test", "license_key", "0123456789abcdef"),
+					resource.TestCheckResourceAttr("sendgrid_partner_settings_new_relic.test", "enable_subuser_statistics", "true"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_partner_settings_new_relic.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testUnitCheckEnforcedTLSDisabled checks that TLS is no longer required, and the version left as is.
+func testUnitCheckEnforcedTLSDisabled(*terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	setting, err := c.ReadEnforcedTLS(context.Background())
+	if err.Err != nil {
+		return err.Err
+	}
+
+	if setting.RequireTLS || setting.RequireValidCert || setting.Version != 1.2 {
+		return fmt.Errorf("enforced TLS not disabled: %+v", setting)
+	}
+
+	return nil
+}
+
+// testUnitCheckNewRelicDisabled checks that the statistics are no longer sent to New Relic.
+func testUnitCheckNewRelicDisabled(*terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	setting, err := c.ReadPartnerSettingsNewRelic(context.Background())
+	if err.Err != nil {
+		return err.Err
+	}
+
+	if setting.Enabled {
+		return fmt.Errorf("new relic partner setting still enabled")
+	}
+
+	return nil
+}
@@ -12,6 +12,27 @@ Example Usage
 	}
 
 ```
+Rotation
+A replacement key is created before the current one is revoked, e.g.
+```hcl
+
+	resource "sendgrid_api_key" "api_key" {
+		name   = "my-api-key"
+		scopes = ["mail.send"]
+
+		rotation {
+			rotate_after = "720h"
+			grace_period = "24h"
+		}
+	}
+
+```
+After a rotation, `api_key` is the new key and `previous_api_key` the former one,
+which stays valid until the first apply after the grace period. The key can't be
+rotated again before then. The resource ID stays the ID of the first key, and
+`current_api_key_id` is the ID of the current one.
+When the current key is deleted outside of Terraform, the previous key is revoked
+too, and the resource is planned for creation.
 Import
 An API key can be imported, e.g.
 ```hcl
@@ -22,7 +43,10 @@ package sendgrid
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
+	"time"
 
 	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -39,6 +63,14 @@ func resourceSendgridAPIKey() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceSendgridAPIKeyCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -60,10 +92,197 @@ func resourceSendgridAPIKey() *schema.Resource {
 				Computed:    true,
 				Sensitive:   true,
 			},
+			"rotation": {
+				Type:        schema.TypeList,
+				Description: "Rotates the API key: a new key is created first, and the previous one is revoked after a grace period.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rotate_after": {
+							Type: schema.TypeString,
+							Description: "Rotate the key once it is older than this duration, e.g. `720h`. " +
+								"The key is rotated on the first apply after the duration elapsed.",
+							Optional:         true,
+							ValidateDiagFunc: validateDuration,
+						},
+						"rotation_trigger": {
+							Type: schema.TypeString,
+							Description: "Arbitrary value rotating the key whenever it changes, e.g. a date or a version. " +
+								"Setting it for the first time doesn't rotate the key.",
+							Optional: true,
+						},
+						"grace_period": {
+							Type: schema.TypeString,
+							Description: "How long the previous key stays valid after a rotation, e.g. `24h`. " +
+								"It is revoked on the first apply after the grace period.",
+							Optional:         true,
+							Default:          "24h",
+							ValidateDiagFunc: validateDuration,
+						},
+					},
+				},
+			},
+			"current_api_key_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the current key, which changes on each rotation. The resource ID is the ID of the first key.",
+				Computed:    true,
+			},
+			"rotated_at": {
+				Type:        schema.TypeString,
+				Description: "When the current key was created, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"previous_api_key": {
+				Type:        schema.TypeString,
+				Description: "The key replaced by the last rotation, until it is revoked.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"previous_api_key_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the key replaced by the last rotation, until it is revoked.",
+				Computed:    true,
+			},
+			"previous_api_key_revoke_after": {
+				Type:        schema.TypeString,
+				Description: "When the grace period of the previous key ends, in RFC 3339 format.",
+				Computed:    true,
+			},
 		},
 	}
 }
 
+// apiKeyRotationAttributes are the attributes changed by a rotation.
+var apiKeyRotationAttributes = []string{ //nolint:gochecknoglobals
+	"api_key",
+	"current_api_key_id",
+	"rotated_at",
+	"previous_api_key",
+	"previous_api_key_id",
+	"previous_api_key_revoke_after",
+}
+
+// resourceGetter reads the attributes of a resource, from a *schema.ResourceData or a *schema.ResourceDiff.
+type resourceGetter interface {
+	Get(key string) interface{}
+	GetChange(key string) (interface{}, interface{})
+}
+
+// apiKeyRotationTriggered returns true when rotation_trigger changed from a previous value.
+func apiKeyRotationTriggered(d resourceGetter) bool {
+	o, n := d.GetChange("rotation.0.rotation_trigger")
+
+	return o.(string) != "" && o.(string) != n.(string)
+}
+
+// apiKeyRotationDue returns true when the key created at rotatedAt is older than rotateAfter.
+func apiKeyRotationDue(rotatedAt, rotateAfter string, now time.Time) bool {
+	if rotatedAt == "" || rotateAfter == "" {
+		return false
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, rotatedAt)
+	if err != nil {
+		return false
+	}
+
+	maxAge, err := time.ParseDuration(rotateAfter)
+	if err != nil {
+		return false
+	}
+
+	return !now.Before(createdAt.Add(maxAge))
+}
+
+// apiKeyRevocationDue returns true when the grace period of the previous key is over.
+func apiKeyRevocationDue(revokeAfter string, now time.Time) bool {
+	if revokeAfter == "" {
+		return false
+	}
+
+	t, err := time.Parse(time.RFC3339, revokeAfter)
+
+	return err == nil && !now.Before(t)
+}
+
+// apiKeyInGracePeriod returns true while the previous key is still valid. The computed
+// attributes are read from the state: they may be unknown in the plan.
+func apiKeyInGracePeriod(d resourceGetter, now time.Time) bool {
+	previousID, _ := d.GetChange("previous_api_key_id")
+	revokeAfter, _ := d.GetChange("previous_api_key_revoke_after")
+
+	return previousID.(string) != "" && !apiKeyRevocationDue(revokeAfter.(string), now)
+}
+
+// apiKeyGracePeriodError is the error of a rotation triggered during the grace period of the previous key.
+func apiKeyGracePeriodError(d resourceGetter) error {
+	previousID, _ := d.GetChange("previous_api_key_id")
+	revokeAfter, _ := d.GetChange("previous_api_key_revoke_after")
+
+	return fmt.Errorf(
+		"the API key can't be rotated before the previous key %s is revoked at the end of its grace period, %s: "+
+			"change rotation_trigger back, and change it again after that time",
+		previousID, revokeAfter,
+	)
+}
+
+// apiKeyRotationNeeded returns true when the current key must be replaced. A key older than
+// rotate_after is only replaced once the grace period of the previous key is over, since only
+// the current and the previous keys are tracked. The computed attributes are read from the
+// state: they may be unknown in the plan.
+func apiKeyRotationNeeded(d resourceGetter, now time.Time) bool {
+	rotatedAt, _ := d.GetChange("rotated_at")
+
+	return apiKeyRotationTriggered(d) ||
+		(apiKeyRotationDue(rotatedAt.(string), d.Get("rotation.0.rotate_after").(string), now) &&
+			!apiKeyInGracePeriod(d, now))
+}
+
+// apiKeyCurrentID returns the ID of the current key. The states written before
+// current_api_key_id existed only hold it as the resource ID.
+func apiKeyCurrentID(d *schema.ResourceData) string {
+	if id := d.Get("current_api_key_id").(string); id != "" {
+		return id
+	}
+
+	return d.Id()
+}
+
+func resourceSendgridAPIKeyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	now := time.Now()
+	rotatedAt, _ := d.GetChange("rotated_at")
+	revokeAfter, _ := d.GetChange("previous_api_key_revoke_after")
+
+	if apiKeyRotationTriggered(d) && apiKeyInGracePeriod(d, now) {
+		return apiKeyGracePeriodError(d)
+	}
+
+	var computed []string
+
+	switch {
+	case apiKeyRotationNeeded(d, now):
+		computed = apiKeyRotationAttributes
+	case apiKeyRevocationDue(revokeAfter.(string), now):
+		computed = []string{"previous_api_key", "previous_api_key_id", "previous_api_key_revoke_after"}
+	case len(d.Get("rotation").([]interface{})) > 0 && rotatedAt.(string) == "":
+		// Keys created before rotations were supported: their age is counted from now on.
+		computed = []string{"rotated_at"}
+	}
+
+	for _, key := range computed {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func scopeInScopes(scopes []string, scope string) bool {
 	for _, v := range scopes {
 		if v == scope {
@@ -74,12 +293,10 @@ func scopeInScopes(scopes []string, scope string) bool {
 	return false
 }
 
-func resourceSendgridAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+// apiKeyScopes returns the scopes of a new API key.
+func apiKeyScopes(d *schema.ResourceData) []string {
 	var scopes []string
 
-	c := m.(*sendgrid.Client)
-	name := d.Get("name").(string)
-
 	for _, scope := range d.Get("scopes").(*schema.Set).List() {
 		scopes = append(scopes, scope.(string))
 	}
@@ -88,19 +305,30 @@ func resourceSendgridAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m
 		scopes = append(scopes, "sender_verification_eligible")
 	}
 
-	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.CreateAPIKey(ctx, name, scopes)
-	})
+	return scopes
+}
 
-	apiKey := apiKeyStruct.(*sendgrid.APIKey)
+func resourceSendgridAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+	name := d.Get("name").(string)
+	scopes := apiKeyScopes(d)
 
+	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, sendgrid.RequestError) {
+		return c.CreateAPIKey(ctx, name, scopes)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	apiKey := apiKeyStruct.(*sendgrid.APIKey)
+
 	d.SetId(apiKey.ID)
 	//nolint:errcheck
+	d.Set("current_api_key_id", apiKey.ID)
+	//nolint:errcheck
 	d.Set("api_key", apiKey.APIKey)
+	//nolint:errcheck
+	d.Set("rotated_at", time.Now().UTC().Format(time.RFC3339))
 
 	return resourceSendgridAPIKeyRead(ctx, d, m)
 }
@@ -108,16 +336,133 @@ func resourceSendgridAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceSendgridAPIKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
-	apiKey, err := c.ReadAPIKey(ctx, d.Id())
+	apiKey, err := c.ReadAPIKey(ctx, apiKeyCurrentID(d))
 	if err.Err != nil {
-		return diag.FromErr(err.Err)
+		// The resource is removed from the state along with the previous key, which
+		// nothing would revoke anymore: it's revoked first, even in its grace period.
+		if previousID := d.Get("previous_api_key_id").(string); previousID != "" && errors.Is(err.Err, sendgrid.ErrNotFound) {
+			_, deleteErr := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, sendgrid.RequestError) {
+				return c.DeleteAPIKey(ctx, previousID)
+			})
+			if deleteErr != nil {
+				return diag.FromErr(deleteErr)
+			}
+		}
+
+		return readDiagnostics(ctx, d, "sendgrid_api_key", err.Err)
 	}
 
+	//nolint:errcheck
+	d.Set("current_api_key_id", apiKey.ID)
 	//nolint:errcheck
 	d.Set("name", apiKey.Name)
 	//nolint:errcheck
 	d.Set("scopes", apiKey.Scopes)
 
+	// The previous key may have been revoked outside of Terraform.
+	if previousID := d.Get("previous_api_key_id").(string); previousID != "" {
+		if _, err = c.ReadAPIKey(ctx, previousID); err.Err != nil {
+			if !errors.Is(err.Err, sendgrid.ErrNotFound) {
+				return diag.FromErr(err.Err)
+			}
+
+			setPreviousAPIKey(d, "", "", "")
+		}
+	}
+
+	return nil
+}
+
+// setPreviousAPIKey sets the key replaced by the last rotation.
+func setPreviousAPIKey(d *schema.ResourceData, id, apiKey, revokeAfter string) {
+	//nolint:errcheck
+	d.Set("previous_api_key_id", id)
+	//nolint:errcheck
+	d.Set("previous_api_key", apiKey)
+	//nolint:errcheck
+	d.Set("previous_api_key_revoke_after", revokeAfter)
+}
+
+// resourceSendgridAPIKeyRotate replaces the current key when a rotation is needed, and revokes
+// the previous key once its grace period is over. The state is updated after each request, so
+// that an apply interrupted halfway neither leaks a key nor loses track of one.
+func resourceSendgridAPIKeyRotate(ctx context.Context, d *schema.ResourceData, c *sendgrid.Client) diag.Diagnostics {
+	now := time.Now().UTC()
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	currentID, _ := d.GetChange("current_api_key_id")
+	currentKey, _ := d.GetChange("api_key")
+	rotatedAt, _ := d.GetChange("rotated_at")
+	previousID, _ := d.GetChange("previous_api_key_id")
+	previousKey, _ := d.GetChange("previous_api_key")
+	revokeAfter, _ := d.GetChange("previous_api_key_revoke_after")
+
+	// The attributes marked as computed by the plan keep their value unless they change below.
+	if rotatedAt.(string) == "" {
+		rotatedAt = now.Format(time.RFC3339)
+	}
+
+	if currentID.(string) == "" {
+		currentID = d.Id()
+	}
+
+	//nolint:errcheck
+	d.Set("current_api_key_id", currentID)
+	//nolint:errcheck
+	d.Set("api_key", currentKey)
+	//nolint:errcheck
+	d.Set("rotated_at", rotatedAt)
+	setPreviousAPIKey(d, previousID.(string), previousKey.(string), revokeAfter.(string))
+
+	rotate := apiKeyRotationNeeded(d, now)
+
+	// The plan already refuses it, unless the grace period was running when it was made.
+	if rotate && apiKeyInGracePeriod(d, now) {
+		return diag.FromErr(apiKeyGracePeriodError(d))
+	}
+
+	// Only the current and the previous keys are tracked: the previous key is revoked
+	// before a rotation creates a new one.
+	if previousID.(string) != "" && (rotate || apiKeyRevocationDue(revokeAfter.(string), now)) {
+		_, err := sendgrid.RetryOnRateLimit(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+			return c.DeleteAPIKey(ctx, previousID.(string))
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		setPreviousAPIKey(d, "", "", "")
+	}
+
+	if !rotate {
+		return nil
+	}
+
+	gracePeriod, parseErr := time.ParseDuration(d.Get("rotation.0.grace_period").(string))
+	if parseErr != nil {
+		return diag.FromErr(parseErr)
+	}
+
+	name := d.Get("name").(string)
+	scopes := apiKeyScopes(d)
+
+	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateAPIKey(ctx, name, scopes)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	apiKey := apiKeyStruct.(*sendgrid.APIKey)
+
+	setPreviousAPIKey(d, currentID.(string), currentKey.(string), now.Add(gracePeriod).Format(time.RFC3339))
+	//nolint:errcheck
+	d.Set("current_api_key_id", apiKey.ID)
+	//nolint:errcheck
+	d.Set("api_key", apiKey.APIKey)
+	//nolint:errcheck
+	d.Set("rotated_at", now.Format(time.RFC3339))
+
 	return nil
 }
 
@@ -132,8 +477,17 @@ func hasDiff(o, n interface{}) bool {
 func resourceSendgridAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
+	if diags := resourceSendgridAPIKeyRotate(ctx, d, c); diags.HasError() {
+		return diags
+	}
+
+	// A rotation alone doesn't change the key: the new key has the configured name and scopes.
+	if !d.HasChanges("name", "scopes") {
+		return resourceSendgridAPIKeyRead(ctx, d, m)
+	}
+
 	a := sendgrid.APIKey{
-		ID:   d.Id(),
+		ID:   apiKeyCurrentID(d),
 		Name: d.Get("name").(string),
 	}
 
@@ -150,8 +504,8 @@ func resourceSendgridAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m
 		a.Scopes = scopes
 	}
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.UpdateAPIKey(ctx, d.Id(), a.Name, a.Scopes)
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateAPIKey(ctx, a.ID, a.Name, a.Scopes)
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -163,8 +517,17 @@ func resourceSendgridAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m
 func resourceSendgridAPIKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.DeleteAPIKey(ctx, d.Id())
+	if previousID := d.Get("previous_api_key_id").(string); previousID != "" {
+		_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, sendgrid.RequestError) {
+			return c.DeleteAPIKey(ctx, previousID)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteAPIKey(ctx, apiKeyCurrentID(d))
 	})
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,283 @@
+package sendgrid
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAPIKeyRotationDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		rotatedAt   string
+		rotateAfter string
+		want        bool
+	}{
+		{name: "no rotate_after", rotatedAt: "2024-01-01T00:00:00Z"},
+		{name: "created before rotations were supported", rotateAfter: "1h"},
+		{name: "not due yet", rotatedAt: "2024-06-01T11:30:00Z", rotateAfter: "1h"},
+		{name: "due", rotatedAt: "2024-06-01T11:00:00Z", rotateAfter: "1h", want: true},
+		{name: "overdue", rotatedAt: "2024-01-01T00:00:00Z", rotateAfter: "720h", want: true},
+		{name: "invalid timestamp", rotatedAt: "yesterday", rotateAfter: "1h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := apiKeyRotationDue(tt.rotatedAt, tt.rotateAfter, now); got != tt.want {
+				t.Errorf("apiKeyRotationDue(%q, %q) = %v, want %v", tt.rotatedAt, tt.rotateAfter, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIKeyRevocationDue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		revokeAfter string
+		want        bool
+	}{
+		{revokeAfter: ""},
+		{revokeAfter: "2024-06-02T12:00:00Z"},
+		{revokeAfter: "2024-06-01T12:00:00Z", want: true},
+		{revokeAfter: "2024-05-31T12:00:00Z", want: true},
+	}
+
+	for _, tt := range tests {
+		if got := apiKeyRevocationDue(tt.revokeAfter, now); got != tt.want {
+			t.Errorf("apiKeyRevocationDue(%q) = %v, want %v", tt.revokeAfter, got, tt.want)
+		}
+	}
+}
+
+// fakeResourceGetter holds the prior and the new values of the attributes of a resource.
+type fakeResourceGetter map[string][2]interface{}
+
+func (g fakeResourceGetter) Get(key string) interface{} {
+	_, n := g.GetChange(key)
+
+	return n
+}
+
+func (g fakeResourceGetter) GetChange(key string) (interface{}, interface{}) {
+	v, ok := g[key]
+	if !ok {
+		return "", ""
+	}
+
+	return v[0], v[1]
+}
+
+func TestAPIKeyRotationNeeded(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		getter fakeResourceGetter
+		want   bool
+	}{
+		{
+			name: "trigger changed",
+			getter: fakeResourceGetter{
+				"rotation.0.rotation_trigger": {"v1", "v2"},
+			},
+			want: true,
+		},
+		{
+			name: "due",
+			getter: fakeResourceGetter{
+				"rotated_at":              {"2024-05-01T00:00:00Z", "2024-05-01T00:00:00Z"},
+				"rotation.0.rotate_after": {"720h", "720h"},
+			},
+			want: true,
+		},
+		{
+			// Rotating would revoke the previous key before the end of its grace period.
+			name: "due during the grace period",
+			getter: fakeResourceGetter{
+				"rotated_at":                    {"2024-05-01T00:00:00Z", "2024-05-01T00:00:00Z"},
+				"rotation.0.rotate_after":       {"720h", "720h"},
+				"previous_api_key_id":           {"previous", "previous"},
+				"previous_api_key_revoke_after": {"2024-06-02T00:00:00Z", "2024-06-02T00:00:00Z"},
+			},
+		},
+		{
+			name: "due after the grace period",
+			getter: fakeResourceGetter{
+				"rotated_at":                    {"2024-05-01T00:00:00Z", "2024-05-01T00:00:00Z"},
+				"rotation.0.rotate_after":       {"720h", "720h"},
+				"previous_api_key_id":           {"previous", "previous"},
+				"previous_api_key_revoke_after": {"2024-06-01T00:00:00Z", "2024-06-01T00:00:00Z"},
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := apiKeyRotationNeeded(tt.getter, now); got != tt.want {
+				t.Errorf("apiKeyRotationNeeded() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// stringAttributes returns the string attributes of a state.
+func stringAttributes(t *testing.T, state tftypes.Value) map[string]string {
+	t.Helper()
+
+	var values map[string]tftypes.Value
+	if err := state.As(&values); err != nil {
+		t.Fatalf("decoding the state: %v", err)
+	}
+
+	attributes := map[string]string{}
+
+	for name, v := range values {
+		var s string
+		if v.Type().Is(tftypes.String) && v.IsKnown() && !v.IsNull() && v.As(&s) == nil {
+			attributes[name] = s
+		}
+	}
+
+	return attributes
+}
+
+func TestResourceSendgridAPIKey_rotationDuringGracePeriod(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	p := newTestProvider(t, server.URL)
+	schema := p.schemas["sendgrid_api_key"]
+
+	config := func(trigger string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":     "deploy",
+			"scopes":   []string{"mail.send"},
+			"rotation": []interface{}{map[string]interface{}{"rotation_trigger": trigger, "grace_period": "1h"}},
+		}
+	}
+
+	_, state, diags := applyResource(t, p, "sendgrid_api_key", tftypes.NewValue(schema.ValueType(), nil), config("v1"))
+	p.checkDiagnostics("PlanResourceChange", diags)
+
+	first := stringAttributes(t, state)
+	if first["current_api_key_id"] != first["id"] {
+		t.Fatalf("current_api_key_id = %q, want the ID %q", first["current_api_key_id"], first["id"])
+	}
+
+	planned, state, diags := applyResource(t, p, "sendgrid_api_key", state, config("v2"))
+	p.checkDiagnostics("PlanResourceChange", diags)
+
+	// Whatever references the current key must wait for the rotation.
+	if _, ok := stringAttributes(t, planned)["current_api_key_id"]; ok {
+		t.Error("the rotation plan knows current_api_key_id, want it unknown")
+	}
+
+	rotated := stringAttributes(t, state)
+	if rotated["id"] != first["id"] {
+		t.Errorf("id = %q after a rotation, want it to stay %q", rotated["id"], first["id"])
+	}
+
+	if rotated["current_api_key_id"] == first["id"] || rotated["api_key"] == first["api_key"] {
+		t.Errorf("current_api_key_id = %q, want a new key", rotated["current_api_key_id"])
+	}
+
+	if rotated["previous_api_key_id"] != first["id"] || rotated["previous_api_key"] != first["api_key"] {
+		t.Errorf("previous_api_key_id = %q, want %q", rotated["previous_api_key_id"], first["id"])
+	}
+
+	// The previous key is still in its grace period: rotating again would revoke it early.
+	_, _, diags = applyResource(t, p, "sendgrid_api_key", state, config("v3"))
+	if len(diags) == 0 || !strings.Contains(diags[0].Detail+diags[0].Summary, "grace period") {
+		t.Errorf("PlanResourceChange() diagnostics = %v, want a grace period error", diags)
+	}
+
+	apiKeys, err := server.Client().ReadAPIKeys(context.Background())
+	if err.Err != nil {
+		t.Fatalf("ReadAPIKeys() error = %v", err.Err)
+	}
+
+	if len(apiKeys) != 2 {
+		t.Errorf("%d API keys exist, want the current and the previous ones", len(apiKeys))
+	}
+}
+
+func TestResourceSendgridAPIKey_currentKeyDeletedDuringGracePeriod(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	p := newTestProvider(t, server.URL)
+	schema := p.schemas["sendgrid_api_key"]
+
+	config := func(trigger string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":     "deploy",
+			"scopes":   []string{"mail.send"},
+			"rotation": []interface{}{map[string]interface{}{"rotation_trigger": trigger, "grace_period": "1h"}},
+		}
+	}
+
+	_, state, diags := applyResource(t, p, "sendgrid_api_key", tftypes.NewValue(schema.ValueType(), nil), config("v1"))
+	p.checkDiagnostics("PlanResourceChange", diags)
+
+	_, state, diags = applyResource(t, p, "sendgrid_api_key", state, config("v2"))
+	p.checkDiagnostics("PlanResourceChange", diags)
+
+	rotated := stringAttributes(t, state)
+	if rotated["previous_api_key_id"] == "" {
+		t.Fatal("no previous key after the rotation")
+	}
+
+	if _, err := server.Client().DeleteAPIKey(ctx, rotated["current_api_key_id"]); err.Err != nil {
+		t.Fatalf("DeleteAPIKey() error = %v", err.Err)
+	}
+
+	currentState, _ := tfprotov5.NewDynamicValue(schema.ValueType(), state)
+
+	readResp, err := p.server.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		TypeName:     "sendgrid_api_key",
+		CurrentState: &currentState,
+	})
+	if err != nil {
+		t.Fatalf("ReadResource() error = %v", err)
+	}
+
+	p.checkDiagnostics("ReadResource", readResp.Diagnostics)
+
+	if !p.decode(schema, readResp.NewState).IsNull() {
+		t.Error("the resource is still in the state, want it removed")
+	}
+
+	// Nothing tracks the previous key anymore: it must not outlive the state.
+	apiKeys, readErr := server.Client().ReadAPIKeys(ctx)
+	if readErr.Err != nil {
+		t.Fatalf("ReadAPIKeys() error = %v", readErr.Err)
+	}
+
+	if len(apiKeys) != 0 {
+		t.Errorf("%d API keys exist, want the previous key revoked", len(apiKeys))
+	}
+}
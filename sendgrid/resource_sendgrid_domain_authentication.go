@@ -38,6 +38,13 @@ func resourceSendgridDomainAuthentication() *schema.Resource { //nolint:funlen
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Type:        schema.TypeString,
@@ -148,7 +155,7 @@ func resourceSendgridDomainAuthenticationCreate(
 		ips = append(ips, ip.(string))
 	}
 
-	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, sendgrid.RequestError) {
 		return c.CreateDomainAuthentication(
 			ctx,
 			domain,
@@ -181,7 +188,7 @@ func resourceSendgridDomainAuthenticationRead( //nolint:funlen,cyclop
 
 	auth, err := c.ReadDomainAuthentication(ctx, d.Id())
 	if err.Err != nil {
-		return diag.FromErr(err.Err)
+		return readDiagnostics(ctx, d, "sendgrid_domain_authentication", err.Err)
 	}
 
 	//nolint:errcheck
@@ -280,7 +287,7 @@ func resourceSendgridDomainAuthenticationUpdate(
 	isDefault := d.Get("is_default").(bool)
 	customSPF := d.Get("custom_spf").(bool)
 
-	auth, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	auth, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
 		return c.UpdateDomainAuthentication(ctx, d.Id(), isDefault, customSPF)
 	})
 	if err != nil {
@@ -288,7 +295,7 @@ func resourceSendgridDomainAuthenticationUpdate(
 	}
 
 	if !auth.(*sendgrid.DomainAuthentication).Valid && d.Get("valid").(bool) {
-		if err := c.ValidateDomainAuthentication(ctx, d.Id()); err.Err != nil || err.StatusCode != 200 {
+		if _, err := c.ValidateDomainAuthentication(ctx, d.Id()); err.Err != nil || err.StatusCode != 200 {
 			if err.Err != nil {
 				return diag.FromErr(err.Err)
 			}
@@ -307,7 +314,7 @@ func resourceSendgridDomainAuthenticationDelete(
 ) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, sendgrid.RequestError) {
 		return c.DeleteDomainAuthentication(ctx, d.Id())
 	})
 	if err != nil {
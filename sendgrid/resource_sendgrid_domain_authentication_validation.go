@@ -0,0 +1,111 @@
+/*
+Provide a resource to wait for the validation of a domain authentication.
+Example Usage
+```hcl
+
+	resource "sendgrid_domain_authentication" "default" {
+		domain = "example.com"
+	}
+
+	resource "aws_route53_record" "sendgrid" {
+		for_each = { for record in sendgrid_domain_authentication.default.dns : record.host => record }
+
+		zone_id = var.zone_id
+		name    = each.value.host
+		type    = upper(each.value.type)
+		ttl     = 300
+		records = [each.value.data]
+	}
+
+	resource "sendgrid_domain_authentication_validation" "default" {
+		domain_authentication_id = sendgrid_domain_authentication.default.id
+
+		depends_on = [aws_route53_record.sendgrid]
+	}
+
+```
+Creating the resource validates the DNS records of the domain authentication until they are all valid.
+It doesn't change anything in SendGrid: destroying it only removes it from the state.
+*/
+package sendgrid
+
+import (
+	"context"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSendgridDomainAuthenticationValidation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridDomainAuthenticationValidationCreate,
+		ReadContext:   resourceSendgridDomainAuthenticationValidationRead,
+		DeleteContext: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_authentication_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the domain authentication to validate.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"interval": validationIntervalSchema(),
+			"records":  validationRecordsSchema(),
+		},
+	}
+}
+
+func resourceSendgridDomainAuthenticationValidationCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	id := d.Get("domain_authentication_id").(string)
+
+	diags := waitForDNSValidation(ctx, d, "domain authentication", id, func(ctx context.Context) (*sendgrid.DNSValidation, sendgrid.RequestError) {
+		return c.ValidateDomainAuthentication(ctx, id)
+	})
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id)
+
+	return resourceSendgridDomainAuthenticationValidationRead(ctx, d, m)
+}
+
+func resourceSendgridDomainAuthenticationValidationRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	auth, err := c.ReadDomainAuthentication(ctx, d.Id())
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_domain_authentication_validation", err.Err)
+	}
+
+	if !auth.Valid {
+		tflog.Warn(ctx, "Domain authentication not valid anymore, removing the validation from the state", map[string]interface{}{
+			"domain_authentication_id": d.Id(),
+		})
+		d.SetId("")
+
+		return nil
+	}
+
+	//nolint:errcheck
+	d.Set("domain_authentication_id", d.Id())
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestUnitSendgridDomainAuthenticationValidation(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	domain := "validation-" + acctest.RandString(10) + ".example.com"
+
+	server.UnpublishDNS("s1._domainkey." + domain)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The DKIM record isn't published: the validation times out with the reason.
+				Config:      testUnitProviderConfig(server) + testUnitSendgridDomainAuthenticationValidationConfig(domain),
+				ExpectError: regexp.MustCompile(`DNS record dkim1 of domain authentication \d+ is not valid(.|\n)*s1\._domainkey\.`),
+			},
+			{
+				PreConfig: func() { server.PublishDNS("s1._domainkey." + domain) },
+				Config:    testUnitProviderConfig(server) + testUnitSendgridDomainAuthenticationValidationConfig(domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"sendgrid_domain_authentication_validation.test", "id",
+						"sendgrid_domain_authentication.test", "id",
+					),
+					resource.TestCheckResourceAttr("sendgrid_domain_authentication_validation.test", "records.%", "3"),
+					resource.TestCheckResourceAttr("sendgrid_domain_authentication_validation.test", "records.dkim1", "true"),
+				),
+			},
+			{
+				// The domain authentication reads as valid once validated.
+				Config: testUnitProviderConfig(server) + testUnitSendgridDomainAuthenticationValidationConfig(domain),
+				Check:  resource.TestCheckResourceAttr("sendgrid_domain_authentication.test", "valid", "true"),
+			},
+		},
+	})
+}
+
+func testUnitSendgridDomainAuthenticationValidationConfig(domain string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_domain_authentication" "test" {
+	domain             = %q
+	automatic_security = false
+}
+
+resource "sendgrid_domain_authentication_validation" "test" {
+	domain_authentication_id = sendgrid_domain_authentication.test.id
+	interval                 = "100ms"
+
+	timeouts {
+		create = "1s"
+	}
+}
+`, domain)
+}
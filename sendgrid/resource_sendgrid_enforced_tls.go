@@ -0,0 +1,120 @@
+/*
+Provide a resource to manage the TLS required from the servers receiving the emails.
+An email whose recipient server doesn't support it is dropped, rather than sent in clear text.
+Example Usage
+```hcl
+
+	resource "sendgrid_enforced_tls" "default" {
+		require_tls        = true
+		require_valid_cert = true
+		version            = "1.2"
+	}
+
+```
+Destroying the resource stops requiring TLS and valid certificates, and leaves the version as is.
+Import
+The enforced TLS setting can be imported with "default", or the subuser name when the provider sets a subuser, e.g.
+```hcl
+$ terraform import sendgrid_enforced_tls.default default
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strconv"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSendgridEnforcedTLS() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridEnforcedTLSUpdate,
+		ReadContext:   resourceSendgridEnforcedTLSRead,
+		UpdateContext: resourceSendgridEnforcedTLSUpdate,
+		DeleteContext: settingDelete(func(ctx context.Context, c *sendgrid.Client) (bool, sendgrid.RequestError) {
+			return c.DisableEnforcedTLS(ctx)
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: importSingleton,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"require_tls": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the recipient servers must support TLS.",
+				Required:    true,
+			},
+			"require_valid_cert": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the recipient servers must have a valid certificate.",
+				Optional:    true,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Description:  "The minimum TLS version the recipient servers must support: 1.1, 1.2 or 1.3.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1.1", "1.2", "1.3"}, false),
+			},
+		},
+	}
+}
+
+func resourceSendgridEnforcedTLSUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting := sendgrid.EnforcedTLS{
+		RequireTLS:       d.Get("require_tls").(bool),
+		RequireValidCert: d.Get("require_valid_cert").(bool),
+	}
+
+	if version, ok := d.GetOk("version"); ok {
+		// The version was validated by the schema.
+		setting.Version, _ = strconv.ParseFloat(version.(string), 64)
+	}
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, singletonTimeout(d), func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateEnforcedTLS(ctx, setting)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(singletonID(c))
+
+	return resourceSendgridEnforcedTLSRead(ctx, d, m)
+}
+
+func resourceSendgridEnforcedTLSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting, err := c.ReadEnforcedTLS(ctx)
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_enforced_tls", err.Err)
+	}
+
+	version := ""
+	if setting.Version != 0 {
+		version = strconv.FormatFloat(setting.Version, 'f', 1, 64)
+	}
+
+	//nolint:errcheck
+	d.Set("require_tls", setting.RequireTLS)
+	//nolint:errcheck
+	d.Set("require_valid_cert", setting.RequireValidCert)
+	//nolint:errcheck
+	d.Set("version", version)
+
+	return nil
+}
@@ -1,9 +1,12 @@
 /*
-Provide a resource to manage an event webhook settings.
+Provide a resource to manage an event webhook. An account can have several event
+webhooks, each posting the selected events to its own URL, with its own OAuth
+settings and signing key.
 Example Usage
 ```hcl
 
 	resource "sendgrid_event_webhook" "default" {
+		friendly_name = "analytics"
 		enabled = true
 	    url = "https://foo.bar/sendgrid/inbound"
 	    group_resubscribe = true
@@ -20,9 +23,27 @@ Example Usage
 	    oauth_client_id = "a-client-id"
 	    oauth_client_secret = "a-client-secret"
 	    oauth_token_url = "https://oauth.example.com/token"
+	    signed = true
 	}
 
+	resource "sendgrid_event_webhook" "engagement" {
+		friendly_name = "engagement"
+		enabled = true
+		url = "https://foo.bar/sendgrid/engagement"
+		events = ["open", "click"]
+	}
+
+```
+The events can be selected with their flags, which post their events unless set to
+false, or with the events argument, which posts only the events it lists.
+Import
+An event webhook can be imported using its ID, e.g.
+```hcl
+$ terraform import sendgrid_event_webhook.default 77d4a5da-7015-11ed-a1eb-0242ac120002
 ```
+The event webhooks managed before several were supported are migrated to the ID of
+the webhook with the same URL on the next refresh. Without one, the webhook is removed
+from the state.
 */
 package sendgrid
 
@@ -32,209 +53,431 @@ import (
 	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func resourceSendgridEventWebhook() *schema.Resource { //nolint:funlen
+func resourceSendgridEventWebhook() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: resourceSendgridEventWebhookPatch,
+		CreateContext: resourceSendgridEventWebhookCreate,
 		ReadContext:   resourceSendgridEventWebhookRead,
-		UpdateContext: resourceSendgridEventWebhookPatch,
+		UpdateContext: resourceSendgridEventWebhookUpdate,
 		DeleteContext: resourceSendgridEventWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
-		Schema: map[string]*schema.Schema{
-			"enabled": {
-				Type:        schema.TypeBool,
-				Description: "Indicates if the event webhook is enabled.",
-				Required:    true,
-			},
-			"url": {
-				Type: schema.TypeString,
-				Description: "The public URL where you would like SendGrid to POST the data events from your email. " +
-					"Any emails sent with the given hostname provided (whose MX records have been updated to point to SendGrid) " +
-					"will be eventd and POSTed to this URL.",
-				Required: true,
-			},
-			"group_resubscribe": {
-				Type: schema.TypeBool,
-				Description: "Recipient resubscribes to specific group by updating preferences. " +
-					"You need to enable Subscription Tracking for getting this type of event.",
-				Optional: true,
-				Default:  true,
-			},
-			"delivered": {
-				Type:        schema.TypeBool,
-				Description: "Message has been successfully delivered to the receiving server.",
-				Optional:    true,
-				Default:     true,
-			},
-			"group_unsubscribe": {
-				Type: schema.TypeBool,
-				Description: "Recipient unsubscribe from specific group, by either direct link or updating preferences. " +
-					"You need to enable Subscription Tracking for getting this type of event.",
-				Optional: true,
-				Default:  true,
-			},
-			"spam_report": {
-				Type:        schema.TypeBool,
-				Description: "Recipient marked a message as spam.",
-				Optional:    true,
-				Default:     true,
-			},
-			"bounce": {
-				Type:        schema.TypeBool,
-				Description: "Receiving server could not or would not accept message.",
-				Optional:    true,
-				Default:     true,
-			},
-			"deferred": {
-				Type:        schema.TypeBool,
-				Description: "Recipient's email server temporarily rejected message.",
-				Optional:    true,
-				Default:     true,
-			},
-			"unsubscribe": {
-				Type: schema.TypeBool,
-				Description: "Recipient clicked on message's subscription management link. " +
-					"You need to enable Subscription Tracking for getting this type of event.",
-				Optional: true,
-				Default:  true,
-			},
-			"processed": {
-				Type:        schema.TypeBool,
-				Description: "Message has been received and is ready to be delivered.",
-				Optional:    true,
-				Default:     true,
-			},
-			"open": {
-				Type: schema.TypeBool,
-				Description: "Recipient has opened the HTML message. " +
-					"You need to enable Open Tracking for getting this type of event.",
-				Optional: true,
-				Default:  true,
-			},
-			"click": {
-				Type: schema.TypeBool,
-				Description: "Recipient clicked on a link within the message. " +
-					"You need to enable Click Tracking for getting this type of event.",
-				Optional: true,
-				Default:  true,
-			},
-			"dropped": {
-				Type: schema.TypeBool,
-				Description: "You may see the following drop reasons: " +
-					"Invalid SMTPAPI header, Spam Content (if spam checker app enabled), " +
-					"Unsubscribed Address, Bounced Address, Spam Reporting Address, Invalid, Recipient List over Package Quota.",
-				Optional: true,
-				Default:  true,
-			},
-			"oauth_client_id": {
-				Type: schema.TypeString,
-				Description: "The client ID Twilio SendGrid sends to your OAuth server or " +
-					"service provider to generate an OAuth access token.",
-				Optional: true,
-			},
-			"oauth_client_secret": {
-				Type: schema.TypeString,
-				Description: "This secret is needed only once to create an access token. SendGrid will store this secret, " +
-					"allowing you to update your Client ID and Token URL without passing the secret to SendGrid again. " +
-					"When passing data in this field, you must also include the oauth_client_id and oauth_token_url fields.",
-				Optional:  true,
-				Sensitive: true,
-			},
-			"oauth_token_url": {
-				Type: schema.TypeString,
-				Description: "The URL where Twilio SendGrid sends the Client ID and Client Secret to generate an access token. " +
-					"This should be your OAuth server or service provider. " +
-					"When passing data in this field, you must also include the oauth_client_id field.",
-				Optional: true,
-			},
-			"signed": {
-				Type:        schema.TypeBool,
-				Description: "Should the event webhook use signing?",
-				Optional:    true,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		CustomizeDiff: resourceSendgridEventWebhookCustomizeDiff,
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceSendgridEventWebhookV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceSendgridEventWebhookStateUpgradeV0,
 			},
-			"public_key": {
-				Type:        schema.TypeString,
-				Description: "The public key used to sign the event webhook. Only present if 'signed' is true",
-				Computed:    true,
+		},
+
+		Schema: eventWebhookSchema(),
+	}
+}
+
+// resourceSendgridEventWebhookV0 is the event webhook when an account had a single
+// one, identified by "default" or the subuser name.
+func resourceSendgridEventWebhookV0() *schema.Resource {
+	s := eventWebhookSchema()
+	delete(s, "friendly_name")
+	delete(s, "events")
+
+	return &schema.Resource{Schema: s}
+}
+
+// resourceSendgridEventWebhookStateUpgradeV0 replaces the ID of a single event webhook
+// by the ID of the webhook with the same URL. Without one, the ID is left as is, and the
+// next refresh removes the webhook from the state.
+func resourceSendgridEventWebhookStateUpgradeV0(
+	ctx context.Context,
+	rawState map[string]interface{},
+	m interface{},
+) (map[string]interface{}, error) {
+	if rawState == nil {
+		return nil, ErrUpgradeStateMissing
+	}
+
+	c, ok := m.(*sendgrid.Client)
+	if !ok || c == nil {
+		return nil, ErrUpgradeStateNotConfigured
+	}
+
+	webhooks, err := c.ReadEventWebhooks(ctx)
+	if err.Err != nil {
+		return nil, err.Err
+	}
+
+	for _, webhook := range webhooks {
+		if webhook.URL == rawState["url"] {
+			rawState["id"] = webhook.ID
+
+			break
+		}
+	}
+
+	return rawState, nil
+}
+
+func eventWebhookSchema() map[string]*schema.Schema { //nolint:funlen
+	return map[string]*schema.Schema{
+		"friendly_name": {
+			Type:        schema.TypeString,
+			Description: "The name telling the event webhook apart from the other webhooks of the account.",
+			Optional:    true,
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Description: "Indicates if the event webhook is enabled.",
+			Required:    true,
+		},
+		"url": {
+			Type: schema.TypeString,
+			Description: "The public URL where you would like SendGrid to POST the data events from your email. " +
+				"Any emails sent with the given hostname provided (whose MX records have been updated to point to SendGrid) " +
+				"will be eventd and POSTed to this URL.",
+			Required: true,
+		},
+		"group_resubscribe": {
+			Type: schema.TypeBool,
+			Description: "Recipient resubscribes to specific group by updating preferences. " +
+				"You need to enable Subscription Tracking for getting this type of event.",
+			Optional: true,
+			Computed: true,
+		},
+		"delivered": {
+			Type:        schema.TypeBool,
+			Description: "Message has been successfully delivered to the receiving server.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"group_unsubscribe": {
+			Type: schema.TypeBool,
+			Description: "Recipient unsubscribe from specific group, by either direct link or updating preferences. " +
+				"You need to enable Subscription Tracking for getting this type of event.",
+			Optional: true,
+			Computed: true,
+		},
+		"spam_report": {
+			Type:        schema.TypeBool,
+			Description: "Recipient marked a message as spam.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"bounce": {
+			Type:        schema.TypeBool,
+			Description: "Receiving server could not or would not accept message.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"deferred": {
+			Type:        schema.TypeBool,
+			Description: "Recipient's email server temporarily rejected message.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"unsubscribe": {
+			Type: schema.TypeBool,
+			Description: "Recipient clicked on message's subscription management link. " +
+				"You need to enable Subscription Tracking for getting this type of event.",
+			Optional: true,
+			Computed: true,
+		},
+		"processed": {
+			Type:        schema.TypeBool,
+			Description: "Message has been received and is ready to be delivered.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"open": {
+			Type: schema.TypeBool,
+			Description: "Recipient has opened the HTML message. " +
+				"You need to enable Open Tracking for getting this type of event.",
+			Optional: true,
+			Computed: true,
+		},
+		"click": {
+			Type: schema.TypeBool,
+			Description: "Recipient clicked on a link within the message. " +
+				"You need to enable Click Tracking for getting this type of event.",
+			Optional: true,
+			Computed: true,
+		},
+		"dropped": {
+			Type: schema.TypeBool,
+			Description: "You may see the following drop reasons: " +
+				"Invalid SMTPAPI header, Spam Content (if spam checker app enabled), " +
+				"Unsubscribed Address, Bounced Address, Spam Reporting Address, Invalid, Recipient List over Package Quota.",
+			Optional: true,
+			Computed: true,
+		},
+		"events": {
+			Type: schema.TypeSet,
+			Description: "The types of events posted, as an alternative to their flags: " +
+				"processed, dropped, delivered, deferred, bounce, open, click, spam_report, " +
+				"unsubscribe, group_unsubscribe and group_resubscribe. " +
+				"Without it, the events whose flag isn't set to false are posted.",
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(eventTypes(), false),
 			},
+			ConflictsWith: eventTypes(),
+		},
+		"oauth_client_id": {
+			Type: schema.TypeString,
+			Description: "The client ID Twilio SendGrid sends to your OAuth server or " +
+				"service provider to generate an OAuth access token.",
+			Optional: true,
+		},
+		"oauth_client_secret": {
+			Type: schema.TypeString,
+			Description: "This secret is needed only once to create an access token. SendGrid will store this secret, " +
+				"allowing you to update your Client ID and Token URL without passing the secret to SendGrid again. " +
+				"When passing data in this field, you must also include the oauth_client_id and oauth_token_url fields.",
+			Optional:  true,
+			Sensitive: true,
+		},
+		"oauth_token_url": {
+			Type: schema.TypeString,
+			Description: "The URL where Twilio SendGrid sends the Client ID and Client Secret to generate an access token. " +
+				"This should be your OAuth server or service provider. " +
+				"When passing data in this field, you must also include the oauth_client_id field.",
+			Optional: true,
+		},
+		"signed": {
+			Type:        schema.TypeBool,
+			Description: "Indicates if the events are signed. A new key pair is generated every time it is turned on.",
+			Optional:    true,
+		},
+		"public_key": {
+			Type:        schema.TypeString,
+			Description: "The public key used to sign the event webhook. Only present if 'signed' is true",
+			Computed:    true,
 		},
 	}
 }
 
-func resourceSendgridEventWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// no op as there is no way to delete it
-	return nil
+// eventTypes returns the types of events, which are also the names of their flags.
+func eventTypes() []string {
+	return eventNames(sendgrid.EventTypes())
 }
 
-func resourceSendgridEventWebhookPatch(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+// resourceSendgridEventWebhookCustomizeDiff keeps the events and their flags in step:
+// the flags follow the events when they are configured, and the events follow the
+// flags otherwise. A flag left out of the configuration posts its events, as it did
+// when the flags were the only way to select them.
+func resourceSendgridEventWebhookCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	config := d.GetRawConfig()
+
+	if events := config.GetAttr("events"); !events.IsNull() {
+		selected := d.Get("events").(*schema.Set)
 
-	enabled := d.Get("enabled").(bool)
+		for _, event := range eventTypes() {
+			var err error
+			if events.IsWhollyKnown() {
+				err = d.SetNew(event, selected.Contains(event))
+			} else {
+				err = d.SetNewComputed(event)
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var events []string
+
+	for _, event := range eventTypes() {
+		flag := config.GetAttr(event)
+		if !flag.IsKnown() {
+			return d.SetNewComputed("events")
+		}
+
+		posted := flag.IsNull() || flag.True()
+		if err := d.SetNew(event, posted); err != nil {
+			return err
+		}
+
+		if posted {
+			events = append(events, event)
+		}
+	}
+
+	return d.SetNew("events", events)
+}
+
+// eventNames returns the names of the types of events.
+func eventNames(events []sendgrid.EventType) []string {
+	names := make([]string, 0, len(events))
+	for _, event := range events {
+		names = append(names, string(event))
+	}
+
+	return names
+}
+
+// changedBool returns the value of a boolean argument when it is sent to SendGrid:
+// always on create, and when it changed on update.
+func changedBool(d *schema.ResourceData, key string, create bool) *bool {
+	if !create && !d.HasChange(key) {
+		return nil
+	}
+
+	value := d.Get(key).(bool)
+
+	return &value
+}
+
+// changedString returns the value of a string argument when it is sent to SendGrid:
+// on create when it is set, and when it changed on update.
+func changedString(d *schema.ResourceData, key string, create bool) *string {
+	value := d.Get(key).(string)
+	if create && value == "" || !create && !d.HasChange(key) {
+		return nil
+	}
+
+	return &value
+}
+
+// eventWebhookRequest returns the request creating the event webhook, or changing
+// the arguments of the resource which changed. SendGrid requires the url and enabled
+// arguments on update, and the OAuth arguments together.
+func eventWebhookRequest(d *schema.ResourceData, create bool) sendgrid.EventWebhookRequest {
 	url := d.Get("url").(string)
-	groupResubscribe := d.Get("group_resubscribe").(bool)
-	delivered := d.Get("delivered").(bool)
-	groupUnsubscribe := d.Get("group_unsubscribe").(bool)
-	spamReport := d.Get("spam_report").(bool)
-	bounce := d.Get("bounce").(bool)
-	deferred := d.Get("deferred").(bool)
-	unsubscribe := d.Get("unsubscribe").(bool)
-	processed := d.Get("processed").(bool)
-	open := d.Get("open").(bool)
-	click := d.Get("click").(bool)
-	dropped := d.Get("dropped").(bool)
-	oauthClientID := d.Get("oauth_client_id").(string)
-	oauthClientSecret := d.Get("oauth_client_secret").(string)
-	oauthTokenURL := d.Get("oauth_token_url").(string)
-
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.PatchEventWebhook(
-			ctx,
-			enabled,
-			url,
-			groupResubscribe,
-			delivered,
-			groupUnsubscribe,
-			spamReport,
-			bounce,
-			deferred,
-			unsubscribe,
-			processed,
-			open,
-			click,
-			dropped,
-			oauthClientID,
-			oauthClientSecret,
-			oauthTokenURL,
-		)
+
+	request := sendgrid.EventWebhookRequest{
+		FriendlyName:     changedString(d, "friendly_name", create),
+		Enabled:          changedBool(d, "enabled", true),
+		URL:              &url,
+		GroupResubscribe: changedBool(d, "group_resubscribe", create),
+		Delivered:        changedBool(d, "delivered", create),
+		GroupUnsubscribe: changedBool(d, "group_unsubscribe", create),
+		SpamReport:       changedBool(d, "spam_report", create),
+		Bounce:           changedBool(d, "bounce", create),
+		Deferred:         changedBool(d, "deferred", create),
+		Unsubscribe:      changedBool(d, "unsubscribe", create),
+		Processed:        changedBool(d, "processed", create),
+		Open:             changedBool(d, "open", create),
+		Click:            changedBool(d, "click", create),
+		Dropped:          changedBool(d, "dropped", create),
+	}
+
+	if create {
+		request.OAuthClientID = changedString(d, "oauth_client_id", true)
+		request.OAuthTokenURL = changedString(d, "oauth_token_url", true)
+
+		// SendGrid keeps the secret, which is only sent when it is set.
+		request.OAuthClientSecret = changedString(d, "oauth_client_secret", true)
+	} else if d.HasChanges("oauth_client_id", "oauth_client_secret", "oauth_token_url") {
+		clientID := d.Get("oauth_client_id").(string)
+		clientSecret := d.Get("oauth_client_secret").(string)
+		tokenURL := d.Get("oauth_token_url").(string)
+
+		request.OAuthClientID = &clientID
+		request.OAuthClientSecret = &clientSecret
+		request.OAuthTokenURL = &tokenURL
+	}
+
+	return request
+}
+
+func resourceSendgridEventWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	request := eventWebhookRequest(d, true)
+
+	webhookStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, sendgrid.RequestError) {
+		return c.CreateEventWebhook(ctx, request)
 	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if d.HasChange("signed") {
-		if _, err := c.ConfigureEventWebhookSigning(ctx, d.Get("signed").(bool)); err.Err != nil {
-			return diag.FromErr(err.Err)
+	d.SetId(webhookStruct.(*sendgrid.EventWebhook).ID)
+
+	if d.Get("signed").(bool) {
+		if diags := resourceSendgridEventWebhookSign(ctx, d, c, schema.TimeoutCreate); diags.HasError() {
+			return diags
 		}
 	}
 
-	if c.OnBehalfOf != "" {
-		d.SetId(c.OnBehalfOf) // since there is only a global event webhook per subuser
-	} else {
-		d.SetId("default") // or at the parent account level
+	return resourceSendgridEventWebhookRead(ctx, d, m)
+}
+
+func resourceSendgridEventWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	request := eventWebhookRequest(d, false)
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateEventWebhook(ctx, d.Id(), request)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("signed") {
+		if diags := resourceSendgridEventWebhookSign(ctx, d, c, schema.TimeoutUpdate); diags.HasError() {
+			return diags
+		}
 	}
 
 	return resourceSendgridEventWebhookRead(ctx, d, m)
 }
 
+// resourceSendgridEventWebhookSign turns the signing of the events on or off, as configured.
+func resourceSendgridEventWebhookSign(
+	ctx context.Context,
+	d *schema.ResourceData,
+	c *sendgrid.Client,
+	timeout string,
+) diag.Diagnostics {
+	signed := d.Get("signed").(bool)
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(timeout), func() (interface{}, sendgrid.RequestError) {
+		return c.ConfigureEventWebhookSigningByID(ctx, d.Id(), signed)
+	})
+
+	return diag.FromErr(err)
+}
+
+func resourceSendgridEventWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteEventWebhook(ctx, d.Id())
+	})
+
+	return diag.FromErr(err)
+}
+
 func resourceSendgridEventWebhookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
-	webhook, err := c.ReadEventWebhook(ctx)
+	webhook, err := c.ReadEventWebhookByID(ctx, d.Id())
 	if err.Err != nil {
-		return diag.FromErr(err.Err)
+		return readDiagnostics(ctx, d, "sendgrid_event_webhook", err.Err)
 	}
 
+	//nolint:errcheck
+	d.Set("friendly_name", webhook.FriendlyName)
 	//nolint:errcheck
 	d.Set("enabled", webhook.Enabled)
 	//nolint:errcheck
@@ -250,7 +493,7 @@ func resourceSendgridEventWebhookRead(ctx context.Context, d *schema.ResourceDat
 	//nolint:errcheck
 	d.Set("bounce", webhook.Bounce)
 	//nolint:errcheck
-	d.Set("deferred", webhook.Delivered)
+	d.Set("deferred", webhook.Deferred)
 	//nolint:errcheck
 	d.Set("unsubscribe", webhook.Unsubscribe)
 	//nolint:errcheck
@@ -262,13 +505,15 @@ func resourceSendgridEventWebhookRead(ctx context.Context, d *schema.ResourceDat
 	//nolint:errcheck
 	d.Set("dropped", webhook.Dropped)
 	//nolint:errcheck
+	d.Set("events", eventNames(webhook.Events()))
+	//nolint:errcheck
 	d.Set("oauth_client_id", webhook.OAuthClientID)
 	//nolint:errcheck
 	d.Set("oauth_token_url", webhook.OAuthTokenURL)
 
-	webhookSigning, err := c.ReadEventWebhookSigning(ctx)
+	webhookSigning, err := c.ReadEventWebhookSigningByID(ctx, d.Id())
 	if err.Err != nil {
-		return diag.FromErr(err.Err)
+		return readDiagnostics(ctx, d, "sendgrid_event_webhook", err.Err)
 	}
 	//nolint:errcheck
 	d.Set("public_key", webhookSigning.PublicKey)
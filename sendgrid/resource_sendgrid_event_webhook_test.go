@@ -3,9 +3,13 @@ package sendgrid_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
 	"testing"
 
 	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -15,9 +19,9 @@ func TestAccSendgridEventWebhookBasic(t *testing.T) {
 	url := "https://example-" + acctest.RandString(10) + ".com/webhook"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckSendgridEventWebhookDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridEventWebhookDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckSendgridEventWebhookConfigBasic(url),
@@ -35,9 +39,9 @@ func TestAccSendgridEventWebhookWithEvents(t *testing.T) {
 	url := "https://events-" + acctest.RandString(10) + ".com/webhook"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckSendgridEventWebhookDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridEventWebhookDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckSendgridEventWebhookConfigWithEvents(url),
@@ -59,9 +63,9 @@ func TestAccSendgridEventWebhookUpdate(t *testing.T) {
 	urlUpdated := "https://updated-" + acctest.RandString(10) + ".com/webhook"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckSendgridEventWebhookDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridEventWebhookDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckSendgridEventWebhookConfigBasic(url),
@@ -85,9 +89,9 @@ func TestAccSendgridEventWebhookWithRateLimiting(t *testing.T) {
 	url := "https://rate-limit-" + acctest.RandString(10) + ".com/webhook"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckSendgridEventWebhookDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridEventWebhookDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckSendgridEventWebhookConfigWithTimeouts(url),
@@ -100,6 +104,191 @@ func TestAccSendgridEventWebhookWithRateLimiting(t *testing.T) {
 	})
 }
 
+func TestUnitSendgridEventWebhooks(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	config := func(signed bool) string {
+		return testUnitProviderConfig(server) + fmt.Sprintf(`
+resource "sendgrid_event_webhook" "analytics" {
+  friendly_name = "analytics"
+  enabled       = true
+  url           = "https://analytics.example.com/events"
+  open          = true
+  click         = true
+  signed        = %t
+}
+
+resource "sendgrid_event_webhook" "deliverability" {
+  friendly_name       = "deliverability"
+  enabled             = true
+  url                 = "https://deliverability.example.com/events"
+  open                = false
+  click               = false
+  oauth_client_id     = "client"
+  oauth_client_secret = "secret"
+  oauth_token_url     = "https://oauth.example.com/token"
+}
+`, signed)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testUnitCheckEventWebhooksDeleted,
+		Steps: []resource.TestStep{
+			{
+				Config: config(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sendgrid_event_webhook.analytics", "public_key"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.deliverability", "public_key", ""),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.deliverability", "friendly_name", "deliverability"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.deliverability", "open", "false"),
+					testUnitCheckEventWebhookIDsDiffer("sendgrid_event_webhook.analytics", "sendgrid_event_webhook.deliverability"),
+				),
+			},
+			{
+				Config: config(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.analytics", "signed", "false"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.analytics", "public_key", ""),
+				),
+			},
+			{
+				// A change made outside of Terraform is reverted.
+				PreConfig: func() {
+					webhooks, err := server.Client().ReadEventWebhooks(context.Background())
+					if err.Err != nil || len(webhooks) != 2 {
+						t.Fatalf("failed listing the event webhooks: %v", err.Err)
+					}
+
+					click := false
+					request := sendgrid.EventWebhookRequest{Enabled: &webhooks[0].Enabled, URL: &webhooks[0].URL, Click: &click}
+
+					if _, err := server.Client().UpdateEventWebhook(context.Background(), webhooks[0].ID, request); err.Err != nil {
+						t.Fatalf("failed changing the event webhook: %v", err.Err)
+					}
+				},
+				Config: config(false),
+				Check:  resource.TestCheckResourceAttr("sendgrid_event_webhook.analytics", "click", "true"),
+			},
+			{
+				ResourceName:            "sendgrid_event_webhook.deliverability",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"oauth_client_secret"},
+			},
+		},
+	})
+}
+
+func TestUnitSendgridEventWebhookEvents(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	config := func(arguments string) string {
+		return testUnitProviderConfig(server) + `
+resource "sendgrid_event_webhook" "test" {
+  enabled = true
+  url     = "https://example.com/events"
+` + arguments + `
+}
+`
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testUnitCheckEventWebhooksDeleted,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(`  events = ["open"]` + "\n" + `  click  = true`),
+				ExpectError: regexp.MustCompile(`"events": conflicts with click`),
+			},
+			{
+				Config:      config(`  events = ["opened"]`),
+				ExpectError: regexp.MustCompile(`expected events.\d+ to be one of`),
+			},
+			{
+				Config: config(`  events = ["open", "click"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.test", "events.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sendgrid_event_webhook.test", "events.*", "open"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.test", "click", "true"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.test", "delivered", "false"),
+				),
+			},
+			{
+				// The flags left out of the configuration post their events again, and only
+				// the changed fields are sent.
+				Config: config(`  open = false`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.test", "events.#", "10"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.test", "open", "false"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.test", "delivered", "true"),
+					testUnitCheckLastEventWebhookPatch(server,
+						`{"group_resubscribe":true,"delivered":true,"group_unsubscribe":true,"spam_report":true,`+
+							`"bounce":true,"deferred":true,"unsubscribe":true,"processed":true,"open":false,"dropped":true}`),
+				),
+			},
+			{
+				Config:   config(`  open = false`),
+				PlanOnly: true,
+			},
+			{
+				Config: config(`  friendly_name = "renamed"
+  open          = false`),
+				Check: testUnitCheckLastEventWebhookPatch(server, `{"friendly_name":"renamed"}`),
+			},
+		},
+	})
+}
+
+// testUnitCheckLastEventWebhookPatch checks the body of the last request changing an event webhook.
+func testUnitCheckLastEventWebhookPatch(server *sendgridtest.Server, want string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		var body string
+
+		for _, r := range server.Requests() {
+			if r.Method == http.MethodPatch && strings.HasPrefix(r.Path, "/user/webhooks/event/settings/") {
+				body = r.Body
+			}
+		}
+
+		if strings.TrimSpace(body) != want {
+			return fmt.Errorf("last event webhook change = %s, want %s", body, want)
+		}
+
+		return nil
+	}
+}
+
+func testUnitCheckEventWebhookIDsDiffer(a, b string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if s.RootModule().Resources[a].Primary.ID == s.RootModule().Resources[b].Primary.ID {
+			return fmt.Errorf("%s and %s have the same ID", a, b)
+		}
+
+		return nil
+	}
+}
+
+// testUnitCheckEventWebhooksDeleted checks that destroying the resources deleted the webhooks.
+func testUnitCheckEventWebhooksDeleted(*terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	webhooks, err := c.ReadEventWebhooks(context.Background())
+	if err.Err != nil {
+		return err.Err
+	}
+
+	if len(webhooks) != 0 {
+		return fmt.Errorf("%d event webhooks still exist", len(webhooks))
+	}
+
+	return nil
+}
+
 func testAccCheckSendgridEventWebhookDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*sendgrid.Client)
 
@@ -109,7 +298,7 @@ func testAccCheckSendgridEventWebhookDestroy(s *terraform.State) error {
 		}
 
 		ctx := context.Background()
-		_, err := c.ReadEventWebhook(ctx)
+		_, err := c.ReadEventWebhookByID(ctx, rs.Primary.ID)
 		if err.StatusCode != 404 && err.Err == nil {
 			return fmt.Errorf("event webhook still exists")
 		}
@@ -171,7 +360,7 @@ func testAccCheckSendgridEventWebhookExists(n string) resource.TestCheckFunc {
 		c := testAccProvider.Meta().(*sendgrid.Client)
 		ctx := context.Background()
 
-		_, err := c.ReadEventWebhook(ctx)
+		_, err := c.ReadEventWebhookByID(ctx, rs.Primary.ID)
 		if err.Err != nil {
 			return fmt.Errorf("event webhook not found")
 		}
@@ -0,0 +1,65 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestResourceSendgridEventWebhookUpdate_oauthSecret(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	p := newTestProvider(t, server.URL)
+	schema := p.schemas["sendgrid_event_webhook"]
+
+	config := func(secret string) map[string]interface{} {
+		return map[string]interface{}{
+			"url":                 "https://example.com/events",
+			"enabled":             true,
+			"click":               true,
+			"oauth_client_id":     "client",
+			"oauth_client_secret": secret,
+			"oauth_token_url":     "https://oauth.example.com/token",
+		}
+	}
+
+	_, state, diags := applyResource(t, p, "sendgrid_event_webhook", tftypes.NewValue(schema.ValueType(), nil), config("secret"))
+	p.checkDiagnostics("PlanResourceChange", diags)
+
+	_, state, diags = applyResource(t, p, "sendgrid_event_webhook", state, config("rotated-secret"))
+	p.checkDiagnostics("PlanResourceChange", diags)
+
+	var body map[string]interface{}
+
+	for _, request := range server.Requests() {
+		if request.Method == "PATCH" && request.Path == "/user/webhooks/event/settings/"+stringAttributes(t, state)["id"] {
+			if err := json.Unmarshal([]byte(request.Body), &body); err != nil {
+				t.Fatalf("decoding %s: %v", request.Body, err)
+			}
+		}
+	}
+
+	// Only the secret changed, but SendGrid requires the other OAuth arguments, url and enabled with it.
+	want := map[string]interface{}{
+		"url":                 "https://example.com/events",
+		"enabled":             true,
+		"oauth_client_id":     "client",
+		"oauth_client_secret": "rotated-secret",
+		"oauth_token_url":     "https://oauth.example.com/token",
+	}
+
+	if len(body) != len(want) {
+		t.Errorf("update request = %v, want %v", body, want)
+	}
+
+	for field, value := range want {
+		if body[field] != value {
+			t.Errorf("update request %s = %v, want %v", field, body[field], value)
+		}
+	}
+}
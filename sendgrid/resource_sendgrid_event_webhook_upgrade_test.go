@@ -0,0 +1,79 @@
+package sendgrid
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceSendgridEventWebhookStateUpgradeV0(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	c := server.Client()
+	ctx := context.Background()
+
+	for _, m := range []interface{}{nil, (*sendgrid.Client)(nil)} {
+		state := map[string]interface{}{"id": "default", "url": "https://example.com/events"}
+		if _, err := resourceSendgridEventWebhookStateUpgradeV0(ctx, state, m); !errors.Is(err, ErrUpgradeStateNotConfigured) {
+			t.Errorf("with meta %#v, error = %v, want %v", m, err, ErrUpgradeStateNotConfigured)
+		}
+	}
+
+	if _, err := resourceSendgridEventWebhookStateUpgradeV0(ctx, nil, c); !errors.Is(err, ErrUpgradeStateMissing) {
+		t.Errorf("without a state, error = %v, want %v", err, ErrUpgradeStateMissing)
+	}
+
+	upgrade := func(url string) string {
+		t.Helper()
+
+		state, err := resourceSendgridEventWebhookStateUpgradeV0(ctx, map[string]interface{}{"id": "default", "url": url}, c)
+		if err != nil {
+			t.Fatalf("upgrade failed: %v", err)
+		}
+
+		return state["id"].(string)
+	}
+
+	if id := upgrade("https://example.com/events"); id != "default" {
+		t.Errorf("without webhooks, id = %q, want it left as is", id)
+	}
+
+	var ids []string
+
+	enabled := true
+
+	for _, url := range []string{"https://oldest.example.com/events", "https://example.com/events"} {
+		webhook, err := c.CreateEventWebhook(ctx, sendgrid.EventWebhookRequest{Enabled: &enabled, URL: &url})
+		if err.Err != nil {
+			t.Fatalf("failed creating an event webhook: %v", err.Err)
+		}
+
+		ids = append(ids, webhook.ID)
+	}
+
+	if id := upgrade("https://example.com/events"); id != ids[1] {
+		t.Errorf("id = %q, want the webhook with the same URL %q", id, ids[1])
+	}
+
+	// Without a webhook with the same URL, the ID is left as is, and the refresh
+	// removes the webhook from the state instead of adopting another one.
+	if id := upgrade("https://moved.example.com/events"); id != "default" {
+		t.Errorf("without a webhook with the same URL, id = %q, want it left as is", id)
+	}
+
+	d := resourceSendgridEventWebhook().Data(&terraform.InstanceState{ID: "default"})
+	if diags := resourceSendgridEventWebhookRead(ctx, d, c); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("after the refresh, id = %q, want the webhook removed from the state", d.Id())
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Provide a resource to suppress emails from all the email sent, whatever their unsubscribe group.
+Example Usage
+```hcl
+
+	resource "sendgrid_global_suppression" "compliance" {
+		emails = ["do-not-contact@example.com"]
+	}
+
+```
+The resource only manages the listed emails: the global unsubscribes of the other recipients
+are left alone. It can't be imported.
+*/
+package sendgrid
+
+import (
+	"context"
+	"strings"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSendgridGlobalSuppression() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridGlobalSuppressionCreate,
+		ReadContext:   resourceSendgridGlobalSuppressionRead,
+		UpdateContext: resourceSendgridGlobalSuppressionUpdate,
+		DeleteContext: resourceSendgridGlobalSuppressionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"emails": {
+				Type:        schema.TypeSet,
+				Description: "The emails globally suppressed.",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, maxEmailLength),
+				},
+			},
+		},
+	}
+}
+
+// syncGlobalSuppressions adds the emails missing from the global suppressions, in batches,
+// and removes the current ones that aren't wanted anymore.
+func syncGlobalSuppressions(
+	ctx context.Context,
+	d *schema.ResourceData,
+	c *sendgrid.Client,
+	timeout string,
+	current, want []string,
+) diag.Diagnostics {
+	if add := missingEmails(want, current); len(add) > 0 {
+		_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(timeout), func() (interface{}, sendgrid.RequestError) {
+			return c.AddGlobalSuppressions(ctx, add)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for _, email := range missingEmails(current, want) {
+		_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(timeout), func() (interface{}, sendgrid.RequestError) {
+			return c.DeleteGlobalSuppression(ctx, email)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceSendgridGlobalSuppressionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	if diags := syncGlobalSuppressions(ctx, d, c, schema.TimeoutCreate, nil, emailList(d.Get("emails"))); diags != nil {
+		return diags
+	}
+
+	d.SetId(id.UniqueId())
+
+	return resourceSendgridGlobalSuppressionRead(ctx, d, m)
+}
+
+// resourceSendgridGlobalSuppressionRead finds the configured emails among the global suppressions.
+// At most one page of the listing is read per configured email: when the account has more
+// suppressions, the emails not found in those pages are checked one at a time instead.
+// SendGrid matches emails case-insensitively.
+func resourceSendgridGlobalSuppressionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	configured := emailList(d.Get("emails"))
+	if len(configured) == 0 {
+		return nil
+	}
+
+	suppressions, complete, err := c.ReadGlobalSuppressions(ctx, len(configured))
+	if err.Err != nil {
+		return diag.FromErr(err.Err)
+	}
+
+	suppressed := make(map[string]bool, len(suppressions))
+	for _, email := range suppressions {
+		suppressed[strings.ToLower(email)] = true
+	}
+
+	var found []string
+
+	for _, email := range configured {
+		if !complete && !suppressed[strings.ToLower(email)] {
+			suppressed[strings.ToLower(email)], err = c.ReadGlobalSuppression(ctx, email)
+			if err.Err != nil {
+				return diag.FromErr(err.Err)
+			}
+		}
+
+		if suppressed[strings.ToLower(email)] {
+			found = append(found, email)
+		}
+	}
+
+	//nolint:errcheck
+	d.Set("emails", found)
+
+	return nil
+}
+
+func resourceSendgridGlobalSuppressionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	oldEmails, newEmails := d.GetChange("emails")
+
+	if diags := syncGlobalSuppressions(ctx, d, c, schema.TimeoutUpdate, emailList(oldEmails), emailList(newEmails)); diags != nil {
+		return diags
+	}
+
+	return resourceSendgridGlobalSuppressionRead(ctx, d, m)
+}
+
+func resourceSendgridGlobalSuppressionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	return syncGlobalSuppressions(ctx, d, c, schema.TimeoutDelete, emailList(d.Get("emails")), nil)
+}
@@ -0,0 +1,101 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+)
+
+func TestResourceSendgridGlobalSuppressionRead(t *testing.T) {
+	t.Parallel()
+
+	manyEmails := []string{"User1@Example.com", "unknown@example.com"}
+	for i := 2; i < 2000; i++ {
+		manyEmails = append(manyEmails, fmt.Sprintf("user%d@example.com", i))
+	}
+
+	tests := []struct {
+		name       string
+		emails     []string
+		wantFound  int
+		wantPages  int
+		wantSingle int
+	}{
+		{
+			// Listing the 3000 suppressions would take 7 requests: the first pages are
+			// read, then the emails not found in them are checked one at a time.
+			name:       "few emails",
+			emails:     []string{"User1@Example.com", "user2999@example.com", "unknown@example.com"},
+			wantFound:  2,
+			wantPages:  3,
+			wantSingle: 2,
+		},
+		{
+			// Six full pages of 500 suppressions, and an empty one ending the listing.
+			name:      "many emails",
+			emails:    manyEmails,
+			wantFound: 1999,
+			wantPages: 7,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := sendgridtest.NewServer()
+			defer server.Close()
+
+			suppressed := make([]string, 0, 3000)
+			for i := range 3000 {
+				suppressed = append(suppressed, fmt.Sprintf("user%d@example.com", i))
+			}
+
+			if _, err := server.Client().AddGlobalSuppressions(context.Background(), suppressed); err.Err != nil {
+				t.Fatalf("failed adding the global suppressions: %v", err.Err)
+			}
+
+			d := resourceSendgridGlobalSuppression().TestResourceData()
+			d.SetId("global-suppression")
+
+			//nolint:errcheck
+			d.Set("emails", tt.emails)
+
+			if diags := resourceSendgridGlobalSuppressionRead(context.Background(), d, server.Client()); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			got := emailList(d.Get("emails"))
+			if len(got) != tt.wantFound {
+				t.Errorf("%d emails found, want %d", len(got), tt.wantFound)
+			}
+
+			if !slices.Contains(got, "User1@Example.com") || slices.Contains(got, "unknown@example.com") {
+				t.Errorf("emails = %v, want User1@Example.com and not unknown@example.com", got)
+			}
+
+			pages, single := 0, 0
+
+			for _, r := range server.Requests() {
+				switch {
+				case r.Method == "GET" && r.Path == "/suppression/unsubscribes":
+					pages++
+				case r.Method == "GET" && strings.HasPrefix(r.Path, "/asm/suppressions/global/"):
+					single++
+				}
+			}
+
+			if pages != tt.wantPages {
+				t.Errorf("%d listing requests, want %d", pages, tt.wantPages)
+			}
+
+			if single != tt.wantSingle {
+				t.Errorf("%d single suppression requests, want %d", single, tt.wantSingle)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitSendgridGlobalSuppression(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	if _, err := server.Client().AddGlobalSuppressions(context.Background(), []string{"someone@example.com"}); err.Err != nil {
+		t.Fatalf("failed adding the global suppression: %v", err.Err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testUnitCheckGlobalSuppression("Compliance@Example.com", false),
+			testUnitCheckGlobalSuppression("qa@example.com", false),
+			testUnitCheckGlobalSuppression("someone@example.com", true),
+			testUnitCheckGlobalSuppression(testUnitLongEmail, false),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + testUnitSendgridGlobalSuppressionConfig(`"Compliance@Example.com", "qa@example.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_global_suppression.test", "emails.#", "2"),
+					resource.TestCheckTypeSetElemAttr("sendgrid_global_suppression.test", "emails.*", "Compliance@Example.com"),
+					testUnitCheckGlobalSuppression("compliance@example.com", true),
+					testUnitCheckGlobalSuppression("qa@example.com", true),
+				),
+			},
+			{
+				Config: testUnitProviderConfig(server) + testUnitSendgridGlobalSuppressionConfig(`"Compliance@Example.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_global_suppression.test", "emails.#", "1"),
+					testUnitCheckGlobalSuppression("qa@example.com", false),
+				),
+			},
+			{
+				// An email removed outside of Terraform is suppressed again.
+				PreConfig: func() {
+					if _, err := server.Client().DeleteGlobalSuppression(context.Background(), "compliance@example.com"); err.Err != nil {
+						t.Fatalf("failed deleting the global suppression: %v", err.Err)
+					}
+				},
+				Config: testUnitProviderConfig(server) + testUnitSendgridGlobalSuppressionConfig(`"Compliance@Example.com"`),
+				Check:  testUnitCheckGlobalSuppression("Compliance@Example.com", true),
+			},
+			{
+				// Addresses can be up to 254 characters long.
+				Config: testUnitProviderConfig(server) +
+					testUnitSendgridGlobalSuppressionConfig(fmt.Sprintf(`"Compliance@Example.com", %q`, testUnitLongEmail)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("sendgrid_global_suppression.test", "emails.*", testUnitLongEmail),
+					testUnitCheckGlobalSuppression(testUnitLongEmail, true),
+				),
+			},
+		},
+	})
+}
+
+func testUnitSendgridGlobalSuppressionConfig(emails string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_global_suppression" "test" {
+  emails = [%s]
+}
+`, emails)
+}
+
+func testUnitCheckGlobalSuppression(email string, want bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		suppressed, err := c.ReadGlobalSuppression(context.Background(), email)
+		if err.Err != nil {
+			return err.Err
+		}
+
+		if suppressed != want {
+			return fmt.Errorf("email %s globally suppressed = %t, want %t", email, suppressed, want)
+		}
+
+		return nil
+	}
+}
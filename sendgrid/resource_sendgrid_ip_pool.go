@@ -0,0 +1,132 @@
+/*
+Provide a resource to manage an IP pool of dedicated IP addresses.
+Example Usage
+```hcl
+
+	resource "sendgrid_ip_pool" "transactional" {
+		name = "transactional"
+	}
+
+```
+Import
+An IP pool can be imported by its name, e.g.
+```hcl
+$ terraform import sendgrid_ip_pool.transactional transactional
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSendgridIPPool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridIPPoolCreate,
+		ReadContext:   resourceSendgridIPPoolRead,
+		UpdateContext: resourceSendgridIPPoolUpdate,
+		DeleteContext: resourceSendgridIPPoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "The name of the IP pool. Renaming the pool keeps its IP addresses.",
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, ipPoolNameLength),
+			},
+			"ips": {
+				Type: schema.TypeSet,
+				Description: "The dedicated IP addresses in the pool when it was last read. " +
+					"Use sendgrid_ip_pool_assignment to add IP addresses to the pool.",
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceSendgridIPPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	name := d.Get("name").(string)
+
+	poolStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, sendgrid.RequestError) {
+		return c.CreateIPPool(ctx, name)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(poolStruct.(*sendgrid.IPPool).Name)
+
+	return resourceSendgridIPPoolRead(ctx, d, m)
+}
+
+func resourceSendgridIPPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	pool, err := c.ReadIPPool(ctx, d.Id())
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_ip_pool", err.Err)
+	}
+
+	ips := make([]string, 0, len(pool.IPs))
+	for _, ip := range pool.IPs {
+		ips = append(ips, ip.IP)
+	}
+
+	//nolint:errcheck
+	d.Set("name", pool.Name)
+	//nolint:errcheck
+	d.Set("ips", ips)
+
+	return nil
+}
+
+func resourceSendgridIPPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+
+		poolStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
+			return c.UpdateIPPool(ctx, d.Id(), name)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// The pool is identified by its name.
+		d.SetId(poolStruct.(*sendgrid.IPPool).Name)
+	}
+
+	return resourceSendgridIPPoolRead(ctx, d, m)
+}
+
+func resourceSendgridIPPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteIPPool(ctx, d.Id())
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+/*
+Provide a resource to add a dedicated IP address to an IP pool.
+Example Usage
+```hcl
+
+	resource "sendgrid_ip_pool" "transactional" {
+		name = "transactional"
+	}
+
+	resource "sendgrid_ip_pool_assignment" "transactional" {
+		pool_name = sendgrid_ip_pool.transactional.name
+		ip        = "192.0.2.10"
+	}
+
+```
+Renaming the pool updates the assignment in place, and keeps the IP address in the pool.
+Import
+An IP pool assignment can be imported with the pool name and the IP address, e.g.
+```hcl
+$ terraform import sendgrid_ip_pool_assignment.transactional transactional/192.0.2.10
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strings"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSendgridIPPoolAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridIPPoolAssignmentCreate,
+		ReadContext:   resourceSendgridIPPoolAssignmentRead,
+		UpdateContext: resourceSendgridIPPoolAssignmentUpdate,
+		DeleteContext: resourceSendgridIPPoolAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"pool_name": {
+				Type:         schema.TypeString,
+				Description:  "The name of the IP pool. Renaming the pool keeps the IP address in it.",
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, ipPoolNameLength),
+			},
+			"ip": {
+				Type:         schema.TypeString,
+				Description:  "The dedicated IP address to add to the pool.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+		},
+	}
+}
+
+// ipPoolAssignmentID returns the ID of the assignment of an IP address to a pool.
+func ipPoolAssignmentID(poolName, ip string) string {
+	return poolName + "/" + ip
+}
+
+// parseIPPoolAssignmentID splits the ID of an assignment. The pool name may contain
+// slashes, the IP address can't.
+func parseIPPoolAssignmentID(id string) (string, string, error) {
+	i := strings.LastIndex(id, "/")
+	if i <= 0 || i == len(id)-1 {
+		return "", "", ErrInvalidIPPoolAssignmentImportFormat
+	}
+
+	return id[:i], id[i+1:], nil
+}
+
+func resourceSendgridIPPoolAssignmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	poolName := d.Get("pool_name").(string)
+	ip := d.Get("ip").(string)
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, sendgrid.RequestError) {
+		return c.AddIPToPool(ctx, poolName, ip)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(ipPoolAssignmentID(poolName, ip))
+
+	return resourceSendgridIPPoolAssignmentRead(ctx, d, m)
+}
+
+func resourceSendgridIPPoolAssignmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	poolName, ip, parseErr := parseIPPoolAssignmentID(d.Id())
+	if parseErr != nil {
+		return diag.FromErr(parseErr)
+	}
+
+	pool, err := c.ReadIPPool(ctx, poolName)
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_ip_pool_assignment", err.Err)
+	}
+
+	if !ipPoolHasIP(pool, ip) {
+		tflog.Warn(ctx, "IP address not in the pool anymore, removing the assignment from the state", map[string]interface{}{
+			"pool_name": poolName,
+			"ip":        ip,
+		})
+		d.SetId("")
+
+		return nil
+	}
+
+	//nolint:errcheck
+	d.Set("pool_name", poolName)
+	//nolint:errcheck
+	d.Set("ip", ip)
+
+	return nil
+}
+
+// ipPoolHasIP tells whether an IP address is in a pool.
+func ipPoolHasIP(pool *sendgrid.IPPool, ip string) bool {
+	for _, poolIP := range pool.IPs {
+		if poolIP.IP == ip {
+			return true
+		}
+	}
+
+	return false
+}
+
+func resourceSendgridIPPoolAssignmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	oldPoolName, newPoolName := d.GetChange("pool_name")
+	ip := d.Get("ip").(string)
+
+	poolStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
+		return c.ReadIPPool(ctx, newPoolName.(string))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// A renamed pool already holds the IP address, only the ID changes. Otherwise the
+	// address moves to another pool.
+	if !ipPoolHasIP(poolStruct.(*sendgrid.IPPool), ip) {
+		_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
+			return c.AddIPToPool(ctx, newPoolName.(string), ip)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
+			return c.RemoveIPFromPool(ctx, oldPoolName.(string), ip)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(ipPoolAssignmentID(newPoolName.(string), ip))
+
+	return resourceSendgridIPPoolAssignmentRead(ctx, d, m)
+}
+
+func resourceSendgridIPPoolAssignmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	poolName := d.Get("pool_name").(string)
+	ip := d.Get("ip").(string)
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, sendgrid.RequestError) {
+		return c.RemoveIPFromPool(ctx, poolName, ip)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
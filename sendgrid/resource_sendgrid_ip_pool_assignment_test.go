@@ -0,0 +1,122 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitSendgridIPPoolAssignmentUpdate(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	server.AddIP("192.0.2.10")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + testUnitSendgridIPPoolAssignmentConfig("transactional", "marketing", "transactional"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool_assignment.test", "id", "transactional/192.0.2.10"),
+					testUnitCheckIPPoolIPs("transactional", "192.0.2.10"),
+				),
+			},
+			{
+				// Renaming the pool keeps the IP address in it: the assignment is only re-keyed,
+				// without removing the address from any pool.
+				Config: testUnitProviderConfig(server) +
+					testUnitSendgridIPPoolAssignmentConfig("transactional-renamed", "marketing", "transactional"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool_assignment.test", "id", "transactional-renamed/192.0.2.10"),
+					resource.TestCheckResourceAttr("sendgrid_ip_pool_assignment.test", "pool_name", "transactional-renamed"),
+					testUnitCheckIPPoolIPs("transactional-renamed", "192.0.2.10"),
+					testUnitCheckIPPoolRequests(server, "DELETE", 0),
+				),
+			},
+			{
+				// The IP address moves to another pool.
+				Config: testUnitProviderConfig(server) +
+					testUnitSendgridIPPoolAssignmentConfig("transactional-renamed", "marketing", "marketing"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool_assignment.test", "id", "marketing/192.0.2.10"),
+					testUnitCheckIPPoolIPs("marketing", "192.0.2.10"),
+					testUnitCheckIPPoolIPs("transactional-renamed"),
+					testUnitCheckIPPoolRequests(server, "DELETE", 1),
+				),
+			},
+		},
+	})
+}
+
+func testUnitSendgridIPPoolAssignmentConfig(first, second, assigned string) string {
+	pool := "first"
+	if assigned == second {
+		pool = "second"
+	}
+
+	return fmt.Sprintf(`
+resource "sendgrid_ip_pool" "first" {
+	name = %q
+}
+
+resource "sendgrid_ip_pool" "second" {
+	name = %q
+}
+
+resource "sendgrid_ip_pool_assignment" "test" {
+	pool_name = sendgrid_ip_pool.%s.name
+	ip        = "192.0.2.10"
+}
+`, first, second, pool)
+}
+
+// testUnitCheckIPPoolIPs checks the IP addresses in a pool.
+func testUnitCheckIPPoolIPs(name string, want ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		pool, err := c.ReadIPPool(context.Background(), name)
+		if err.Err != nil {
+			return err.Err
+		}
+
+		got := make([]string, 0, len(pool.IPs))
+		for _, poolIP := range pool.IPs {
+			got = append(got, poolIP.IP)
+		}
+
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			return fmt.Errorf("IP pool %s holds %v, want %v", name, got, want)
+		}
+
+		return nil
+	}
+}
+
+// testUnitCheckIPPoolRequests counts the requests on the IP addresses of the pools.
+func testUnitCheckIPPoolRequests(server *sendgridtest.Server, method string, want int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		count := 0
+
+		for _, r := range server.Requests() {
+			pool, ok := strings.CutPrefix(r.Path, "/ips/pools/")
+			if r.Method == method && ok && strings.Contains(pool, "/ips/") {
+				count++
+			}
+		}
+
+		if count != want {
+			return fmt.Errorf("%d %s requests on the IP addresses of the pools, want %d", count, method, want)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,149 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSendgridIPPoolBasic(t *testing.T) {
+	name := "terraform-pool-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridIPPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridIPPoolConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.test", "name", name),
+				),
+			},
+			{
+				Config: testAccCheckSendgridIPPoolConfigBasic(name + "-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.test", "id", name+"-renamed"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_ip_pool.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitSendgridIPPool(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	server.AddIP("192.0.2.10")
+
+	name := "terraform-pool-" + acctest.RandString(10)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridIPPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + testAccCheckSendgridIPPoolConfigAssignment(name, "192.0.2.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.test", "name", name),
+					resource.TestCheckResourceAttr("sendgrid_ip_pool_assignment.test", "id", name+"/192.0.2.10"),
+					testAccCheckSendgridIPPoolHasIP(name, "192.0.2.10"),
+				),
+			},
+			{
+				// Renaming the pool keeps its IP addresses, the assignment follows the new name.
+				Config: testUnitProviderConfig(server) + testAccCheckSendgridIPPoolConfigAssignment(name+"-renamed", "192.0.2.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.test", "id", name+"-renamed"),
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.test", "ips.#", "1"),
+					resource.TestCheckResourceAttr("sendgrid_ip_pool_assignment.test", "pool_name", name+"-renamed"),
+					testAccCheckSendgridIPPoolHasIP(name+"-renamed", "192.0.2.10"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_ip_pool.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sendgrid_ip_pool_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// The IP address was removed from the pool outside of Terraform: it is added again.
+				PreConfig: func() {
+					if _, err := server.Client().RemoveIPFromPool(context.Background(), name+"-renamed", "192.0.2.10"); err.Err != nil {
+						t.Fatalf("failed removing the IP address from the pool: %v", err.Err)
+					}
+				},
+				Config: testUnitProviderConfig(server) + testAccCheckSendgridIPPoolConfigAssignment(name+"-renamed", "192.0.2.10"),
+				Check:  testAccCheckSendgridIPPoolHasIP(name+"-renamed", "192.0.2.10"),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridIPPoolDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_ip_pool" {
+			continue
+		}
+
+		if _, err := c.ReadIPPool(context.Background(), rs.Primary.ID); err.Err == nil {
+			return fmt.Errorf("IP pool still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridIPPoolHasIP(name, ip string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		pool, err := c.ReadIPPool(context.Background(), name)
+		if err.Err != nil {
+			return err.Err
+		}
+
+		for _, poolIP := range pool.IPs {
+			if poolIP.IP == ip {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("IP pool %s doesn't hold %s: %+v", name, ip, pool.IPs)
+	}
+}
+
+func testAccCheckSendgridIPPoolConfigBasic(name string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_ip_pool" "test" {
+	name = "%s"
+}
+`, name)
+}
+
+func testAccCheckSendgridIPPoolConfigAssignment(name, ip string) string {
+	return testAccCheckSendgridIPPoolConfigBasic(name) + fmt.Sprintf(`
+resource "sendgrid_ip_pool_assignment" "test" {
+	pool_name = sendgrid_ip_pool.test.name
+	ip        = "%s"
+}
+`, ip)
+}
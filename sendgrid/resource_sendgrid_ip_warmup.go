@@ -0,0 +1,143 @@
+/*
+Provide a resource to warm up a dedicated IP address.
+Example Usage
+```hcl
+
+	resource "sendgrid_ip_warmup" "new" {
+		ip = "192.0.2.10"
+	}
+
+```
+SendGrid limits the volume of email sent from an IP address in warmup, and raises the
+limit every day. Destroying the resource stops the warmup.
+Import
+An IP warmup can be imported by its IP address, e.g.
+```hcl
+$ terraform import sendgrid_ip_warmup.new 192.0.2.10
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"time"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// warmupDay is the period after which SendGrid raises the volume of an IP address in warmup.
+const warmupDay = 24 * time.Hour
+
+func resourceSendgridIPWarmup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridIPWarmupCreate,
+		ReadContext:   resourceSendgridIPWarmupRead,
+		DeleteContext: resourceSendgridIPWarmupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:         schema.TypeString,
+				Description:  "The dedicated IP address to warm up.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"warmup": {
+				Type: schema.TypeBool,
+				Description: "True while the IP address is in warmup. It turns false once SendGrid completes the warmup, " +
+					"and the warmup is not started again.",
+				Computed: true,
+			},
+			"start_date": {
+				Type:        schema.TypeString,
+				Description: "When the warmup started, in RFC 3339 format. Empty once the warmup is over.",
+				Computed:    true,
+			},
+			"warmup_days": {
+				Type:        schema.TypeInt,
+				Description: "The number of full days since the warmup started. 0 once the warmup is over.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSendgridIPWarmupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	ip := d.Get("ip").(string)
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, sendgrid.RequestError) {
+		return c.StartIPWarmup(ctx, ip)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(ip)
+
+	return resourceSendgridIPWarmupRead(ctx, d, m)
+}
+
+// warmupDays returns the number of full days since the warmup started at startDate.
+func warmupDays(startDate int64, now time.Time) int {
+	if startDate == 0 {
+		return 0
+	}
+
+	return int(now.Sub(time.Unix(startDate, 0)) / warmupDay)
+}
+
+func resourceSendgridIPWarmupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	// The IP address tells whether it is in warmup, the warmup itself is not found once it is over.
+	ip, err := c.ReadIP(ctx, d.Id())
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_ip_warmup", err.Err)
+	}
+
+	startDate := ip.StartDate
+	if !ip.Warmup {
+		tflog.Debug(ctx, "IP address not in warmup anymore", map[string]interface{}{"ip": ip.IP})
+
+		startDate = 0
+	}
+
+	//nolint:errcheck
+	d.Set("ip", ip.IP)
+	//nolint:errcheck
+	d.Set("warmup", ip.Warmup)
+	//nolint:errcheck
+	d.Set("start_date", unixTime(startDate))
+	//nolint:errcheck
+	d.Set("warmup_days", warmupDays(startDate, time.Now()))
+
+	return nil
+}
+
+func resourceSendgridIPWarmupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, sendgrid.RequestError) {
+		return c.StopIPWarmup(ctx, d.Id())
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
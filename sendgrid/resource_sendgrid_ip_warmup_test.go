@@ -0,0 +1,197 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitSendgridIPWarmup(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	server.AddIP("192.0.2.10")
+	server.AddIP("192.0.2.11")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testUnitCheckSendgridIPWarmupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + testUnitSendgridIPWarmupConfig("192.0.2.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_warmup.test", "id", "192.0.2.10"),
+					resource.TestCheckResourceAttr("sendgrid_ip_warmup.test", "warmup", "true"),
+					resource.TestCheckResourceAttr("sendgrid_ip_warmup.test", "warmup_days", "0"),
+					resource.TestCheckResourceAttrSet("sendgrid_ip_warmup.test", "start_date"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_ip_warmup.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// The warmup is over: it is reported, and not started again.
+				PreConfig: func() {
+					if _, err := server.Client().StopIPWarmup(context.Background(), "192.0.2.10"); err.Err != nil {
+						t.Fatalf("failed stopping the warmup: %v", err.Err)
+					}
+				},
+				Config: testUnitProviderConfig(server) + testUnitSendgridIPWarmupConfig("192.0.2.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_warmup.test", "warmup", "false"),
+					resource.TestCheckResourceAttr("sendgrid_ip_warmup.test", "start_date", ""),
+					resource.TestCheckResourceAttr("sendgrid_ip_warmup.test", "warmup_days", "0"),
+					testUnitCheckIPWarmup("192.0.2.10", false),
+				),
+			},
+			{
+				Config: testUnitProviderConfig(server) + testUnitSendgridIPWarmupConfig("192.0.2.11"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_warmup.test", "id", "192.0.2.11"),
+					testUnitCheckIPWarmup("192.0.2.11", true),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourceSendgridIPs(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	for _, ip := range []string{"192.0.2.10", "192.0.2.11", "192.0.2.12"} {
+		server.AddIP(ip)
+	}
+
+	client := server.Client()
+	ctx := context.Background()
+
+	if _, err := client.AddIPToPool(ctx, mustCreateIPPool(t, client, "marketing"), "192.0.2.11"); err.Err != nil {
+		t.Fatalf("failed adding the IP address to the pool: %v", err.Err)
+	}
+
+	if _, err := client.StartIPWarmup(ctx, "192.0.2.12"); err.Err != nil {
+		t.Fatalf("failed starting the warmup: %v", err.Err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + `
+data "sendgrid_ips" "all" {}
+
+data "sendgrid_ips" "marketing" {
+  pool = "marketing"
+}
+
+data "sendgrid_ips" "warmup" {
+  warmup = true
+}
+
+data "sendgrid_ips" "not_warmup" {
+  warmup = false
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_ips.all", "addresses.#", "3"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.all", "ips.0.assigned", "false"),
+					resource.TestCheckResourceAttrSet("data.sendgrid_ips.all", "ips.0.rdns"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.marketing", "addresses.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.marketing", "addresses.0", "192.0.2.11"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.marketing", "ips.0.pools.0", "marketing"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.warmup", "addresses.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.warmup", "addresses.0", "192.0.2.12"),
+					resource.TestCheckResourceAttrSet("data.sendgrid_ips.warmup", "ips.0.warmup_start_date"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.not_warmup", "addresses.#", "2"),
+				),
+			},
+			{
+				// The IP addresses of a subuser are assigned.
+				Config: testUnitProviderConfig(server) + `
+resource "sendgrid_subuser" "test" {
+  username = "terraform-ips"
+  email    = "terraform-ips@example.com"
+  password = "Passw0rd!Passw0rd"
+  ips      = ["192.0.2.10"]
+}
+
+data "sendgrid_ips" "subuser" {
+  subuser = sendgrid_subuser.test.username
+}
+
+data "sendgrid_ips" "unassigned" {
+  assigned   = false
+  depends_on = [sendgrid_subuser.test]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_ips.subuser", "addresses.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.subuser", "addresses.0", "192.0.2.10"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.subuser", "ips.0.assigned", "true"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.subuser", "ips.0.subusers.0", "terraform-ips"),
+					resource.TestCheckResourceAttr("data.sendgrid_ips.unassigned", "addresses.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func mustCreateIPPool(t *testing.T, client *sendgrid.Client, name string) string {
+	t.Helper()
+
+	pool, err := client.CreateIPPool(context.Background(), name)
+	if err.Err != nil {
+		t.Fatalf("failed creating the IP pool: %v", err.Err)
+	}
+
+	return pool.Name
+}
+
+func testUnitSendgridIPWarmupConfig(ip string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_ip_warmup" "test" {
+  ip = %q
+}
+`, ip)
+}
+
+func testUnitCheckIPWarmup(ip string, want bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		address, err := c.ReadIP(context.Background(), ip)
+		if err.Err != nil {
+			return err.Err
+		}
+
+		if address.Warmup != want {
+			return fmt.Errorf("IP address %s warmup = %t, want %t", ip, address.Warmup, want)
+		}
+
+		return nil
+	}
+}
+
+func testUnitCheckSendgridIPWarmupDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_ip_warmup" {
+			continue
+		}
+
+		if err := testUnitCheckIPWarmup(rs.Primary.ID, false)(s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -36,6 +36,13 @@ func resourceSendgridLinkBranding() *schema.Resource { //nolint:funlen
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Type:        schema.TypeString,
@@ -107,7 +114,7 @@ func resourceSendgridLinkBrandingCreate(ctx context.Context, d *schema.ResourceD
 	subdomain := d.Get("subdomain").(string)
 	isDefault := d.Get("is_default").(bool)
 
-	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, sendgrid.RequestError) {
 		return c.CreateLinkBranding(ctx, domain, subdomain, isDefault)
 	})
 
@@ -127,7 +134,7 @@ func resourceSendgridLinkBrandingRead(ctx context.Context, d *schema.ResourceDat
 
 	link, err := c.ReadLinkBranding(ctx, d.Id())
 	if err.Err != nil {
-		return diag.FromErr(err.Err)
+		return readDiagnostics(ctx, d, "sendgrid_link_branding", err.Err)
 	}
 
 	//nolint:errcheck
@@ -172,7 +179,7 @@ func resourceSendgridLinkBrandingUpdate(ctx context.Context, d *schema.ResourceD
 
 	isDefault := d.Get("is_default").(bool)
 
-	link, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	link, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
 		return c.UpdateLinkBranding(ctx, d.Id(), isDefault)
 	})
 	if err != nil {
@@ -180,7 +187,7 @@ func resourceSendgridLinkBrandingUpdate(ctx context.Context, d *schema.ResourceD
 	}
 
 	if !link.(*sendgrid.LinkBranding).Valid && d.Get("valid").(bool) {
-		if err := c.ValidateLinkBranding(ctx, d.Id()); err.Err != nil || err.StatusCode != 200 {
+		if _, err := c.ValidateLinkBranding(ctx, d.Id()); err.Err != nil || err.StatusCode != 200 {
 			if err.Err != nil {
 				return diag.FromErr(err.Err)
 			}
@@ -195,7 +202,7 @@ func resourceSendgridLinkBrandingUpdate(ctx context.Context, d *schema.ResourceD
 func resourceSendgridLinkBrandingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, sendgrid.RequestError) {
 		return c.DeleteLinkBranding(ctx, d.Id())
 	})
 	if err != nil {
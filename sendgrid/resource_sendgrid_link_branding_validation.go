@@ -0,0 +1,103 @@
+/*
+Provide a resource to wait for the validation of a link branding.
+Example Usage
+```hcl
+
+	resource "sendgrid_link_branding" "default" {
+		domain = "example.com"
+	}
+
+	resource "aws_route53_record" "sendgrid_links" {
+		for_each = { for record in sendgrid_link_branding.default.dns : record.host => record }
+
+		zone_id = var.zone_id
+		name    = each.value.host
+		type    = upper(each.value.type)
+		ttl     = 300
+		records = [each.value.data]
+	}
+
+	resource "sendgrid_link_branding_validation" "default" {
+		link_branding_id = sendgrid_link_branding.default.id
+
+		depends_on = [aws_route53_record.sendgrid_links]
+	}
+
+```
+Creating the resource validates the DNS records of the link branding until they are all valid.
+It doesn't change anything in SendGrid: destroying it only removes it from the state.
+*/
+package sendgrid
+
+import (
+	"context"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSendgridLinkBrandingValidation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridLinkBrandingValidationCreate,
+		ReadContext:   resourceSendgridLinkBrandingValidationRead,
+		DeleteContext: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"link_branding_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the link branding to validate.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"interval": validationIntervalSchema(),
+			"records":  validationRecordsSchema(),
+		},
+	}
+}
+
+func resourceSendgridLinkBrandingValidationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	id := d.Get("link_branding_id").(string)
+
+	diags := waitForDNSValidation(ctx, d, "link branding", id, func(ctx context.Context) (*sendgrid.DNSValidation, sendgrid.RequestError) {
+		return c.ValidateLinkBranding(ctx, id)
+	})
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id)
+
+	return resourceSendgridLinkBrandingValidationRead(ctx, d, m)
+}
+
+func resourceSendgridLinkBrandingValidationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	link, err := c.ReadLinkBranding(ctx, d.Id())
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_link_branding_validation", err.Err)
+	}
+
+	if !link.Valid {
+		tflog.Warn(ctx, "Link branding not valid anymore, removing the validation from the state", map[string]interface{}{
+			"link_branding_id": d.Id(),
+		})
+		d.SetId("")
+
+		return nil
+	}
+
+	//nolint:errcheck
+	d.Set("link_branding_id", d.Id())
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestUnitSendgridLinkBrandingValidation(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	domain := "links-" + acctest.RandString(10) + ".example.com"
+
+	server.UnpublishDNS("url." + domain)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testUnitProviderConfig(server) + testUnitSendgridLinkBrandingValidationConfig(domain),
+				ExpectError: regexp.MustCompile(`DNS record domain_cname of link branding \d+ is not valid`),
+			},
+			{
+				PreConfig: func() { server.PublishDNS("url." + domain) },
+				Config:    testUnitProviderConfig(server) + testUnitSendgridLinkBrandingValidationConfig(domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_link_branding_validation.test", "records.domain_cname", "true"),
+					resource.TestCheckResourceAttr("sendgrid_link_branding_validation.test", "records.owner_cname", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testUnitSendgridLinkBrandingValidationConfig(domain string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_link_branding" "test" {
+	domain    = %q
+	subdomain = "url"
+}
+
+resource "sendgrid_link_branding_validation" "test" {
+	link_branding_id = sendgrid_link_branding.test.id
+	interval         = "100ms"
+
+	timeouts {
+		create = "1s"
+	}
+}
+`, domain)
+}
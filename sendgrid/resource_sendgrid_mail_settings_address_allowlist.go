@@ -0,0 +1,105 @@
+/*
+Provide a resource to manage the emails and domains never suppressed, e.g. after a bounce.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_address_allowlist" "default" {
+		enabled = true
+		entries = ["example.com", "ceo@partner.example"]
+	}
+
+```
+Destroying the resource disables the allowlist, and leaves its entries as is.
+Import
+The address allowlist setting can be imported with "default", or the subuser name when the provider sets a subuser, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_address_allowlist.default default
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSendgridMailSettingsAddressAllowlist() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridMailSettingsAddressAllowlistUpdate,
+		ReadContext:   resourceSendgridMailSettingsAddressAllowlistRead,
+		UpdateContext: resourceSendgridMailSettingsAddressAllowlistUpdate,
+		DeleteContext: mailSettingDelete(sendgrid.MailSettingAddressAllowlist),
+		Importer: &schema.ResourceImporter{
+			StateContext: importSingleton,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the allowlist is enabled.",
+				Required:    true,
+			},
+			"entries": {
+				Type: schema.TypeSet,
+				Description: "The emails and domains never suppressed. " +
+					"A domain allows every email of the domain.",
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceSendgridMailSettingsAddressAllowlistUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting := sendgrid.MailSettingsAddressAllowlist{
+		Enabled: d.Get("enabled").(bool),
+		List:    emailList(d.Get("entries")),
+	}
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, singletonTimeout(d), func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateMailSettingsAddressAllowlist(ctx, setting)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(singletonID(c))
+
+	return resourceSendgridMailSettingsAddressAllowlistRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsAddressAllowlistRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting, err := c.ReadMailSettingsAddressAllowlist(ctx)
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_mail_settings_address_allowlist", err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("entries", setting.List)
+
+	return nil
+}
@@ -0,0 +1,95 @@
+/*
+Provide a resource to manage the address receiving a blind carbon copy (BCC) of every email.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_bcc" "default" {
+		enabled = true
+		email   = "archive@example.com"
+	}
+
+```
+Destroying the resource disables the setting, and leaves its email as is.
+Import
+The BCC setting can be imported with "default", or the subuser name when the provider sets a subuser, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_bcc.default default
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSendgridMailSettingsBCC() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridMailSettingsBCCUpdate,
+		ReadContext:   resourceSendgridMailSettingsBCCRead,
+		UpdateContext: resourceSendgridMailSettingsBCCUpdate,
+		DeleteContext: mailSettingDelete(sendgrid.MailSettingBCC),
+		Importer: &schema.ResourceImporter{
+			StateContext: importSingleton,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if a blind carbon copy of the emails is sent.",
+				Required:    true,
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The email receiving a blind carbon copy of the emails.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceSendgridMailSettingsBCCUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting := sendgrid.MailSettingsBCC{
+		Enabled: d.Get("enabled").(bool),
+		Email:   d.Get("email").(string),
+	}
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, singletonTimeout(d), func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateMailSettingsBCC(ctx, setting)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(singletonID(c))
+
+	return resourceSendgridMailSettingsBCCRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsBCCRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting, err := c.ReadMailSettingsBCC(ctx)
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_mail_settings_bcc", err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("email", setting.Email)
+
+	return nil
+}
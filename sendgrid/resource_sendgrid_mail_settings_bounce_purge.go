@@ -0,0 +1,131 @@
+/*
+Provide a resource to manage the purge of the bounces after a number of days.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_bounce_purge" "default" {
+		enabled      = true
+		soft_bounces = 7
+		hard_bounces = 90
+	}
+
+```
+Destroying the resource disables the setting, and leaves its numbers of days as is.
+Import
+The bounce purge setting can be imported with "default", or the subuser name when the provider sets a subuser, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_bounce_purge.default default
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// maxBouncePurgeDays is the longest a bounce can be kept before it is purged.
+const maxBouncePurgeDays = 3650
+
+func resourceSendgridMailSettingsBouncePurge() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridMailSettingsBouncePurgeUpdate,
+		ReadContext:   resourceSendgridMailSettingsBouncePurgeRead,
+		UpdateContext: resourceSendgridMailSettingsBouncePurgeUpdate,
+		DeleteContext: mailSettingDelete(sendgrid.MailSettingBouncePurge),
+		Importer: &schema.ResourceImporter{
+			StateContext: importSingleton,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the bounces are purged.",
+				Required:    true,
+			},
+			"soft_bounces": {
+				Type:         schema.TypeInt,
+				Description:  "The number of days after which the soft bounces are purged. They are kept when unset.",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, maxBouncePurgeDays),
+			},
+			"hard_bounces": {
+				Type:         schema.TypeInt,
+				Description:  "The number of days after which the hard bounces are purged. They are kept when unset.",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, maxBouncePurgeDays),
+			},
+		},
+	}
+}
+
+// optionalDays returns the number of days of an argument, or nil if it isn't set.
+func optionalDays(d *schema.ResourceData, name string) *int {
+	raw, ok := d.GetOk(name)
+	if !ok {
+		return nil
+	}
+
+	value := raw.(int)
+
+	return &value
+}
+
+// days returns a number of days read from SendGrid, or 0 if it isn't set.
+func days(value *int) int {
+	if value == nil {
+		return 0
+	}
+
+	return *value
+}
+
+func resourceSendgridMailSettingsBouncePurgeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting := sendgrid.MailSettingsBouncePurge{
+		Enabled:     d.Get("enabled").(bool),
+		SoftBounces: optionalDays(d, "soft_bounces"),
+		HardBounces: optionalDays(d, "hard_bounces"),
+	}
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, singletonTimeout(d), func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateMailSettingsBouncePurge(ctx, setting)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(singletonID(c))
+
+	return resourceSendgridMailSettingsBouncePurgeRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsBouncePurgeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting, err := c.ReadMailSettingsBouncePurge(ctx)
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_mail_settings_bounce_purge", err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("soft_bounces", days(setting.SoftBounces))
+	//nolint:errcheck
+	d.Set("hard_bounces", days(setting.HardBounces))
+
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+Provide a resource to manage the footer added to every email.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_footer" "default" {
+		enabled       = true
+		html_content  = "<p>Example Inc, 1 Example Street, Denver</p>"
+		plain_content = "Example Inc, 1 Example Street, Denver"
+	}
+
+```
+Destroying the resource disables the footer, and leaves its content as is.
+Import
+The footer setting can be imported with "default", or the subuser name when the provider sets a subuser, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_footer.default default
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSendgridMailSettingsFooter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridMailSettingsFooterUpdate,
+		ReadContext:   resourceSendgridMailSettingsFooterRead,
+		UpdateContext: resourceSendgridMailSettingsFooterUpdate,
+		DeleteContext: mailSettingDelete(sendgrid.MailSettingFooter),
+		Importer: &schema.ResourceImporter{
+			StateContext: importSingleton,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the footer is added to the emails.",
+				Required:    true,
+			},
+			"html_content": {
+				Type:        schema.TypeString,
+				Description: "The footer added to the HTML part of the emails.",
+				Optional:    true,
+			},
+			"plain_content": {
+				Type:        schema.TypeString,
+				Description: "The footer added to the plain text part of the emails.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceSendgridMailSettingsFooterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting := sendgrid.MailSettingsFooter{
+		Enabled:      d.Get("enabled").(bool),
+		HTMLContent:  d.Get("html_content").(string),
+		PlainContent: d.Get("plain_content").(string),
+	}
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, singletonTimeout(d), func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateMailSettingsFooter(ctx, setting)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(singletonID(c))
+
+	return resourceSendgridMailSettingsFooterRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsFooterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting, err := c.ReadMailSettingsFooter(ctx)
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_mail_settings_footer", err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("html_content", setting.HTMLContent)
+	//nolint:errcheck
+	d.Set("plain_content", setting.PlainContent)
+
+	return nil
+}
@@ -0,0 +1,95 @@
+/*
+Provide a resource to manage the address the bounce reports are forwarded to.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_forward_bounce" "default" {
+		enabled = true
+		email   = "bounces@example.com"
+	}
+
+```
+Destroying the resource disables the setting, and leaves its email as is.
+Import
+The forward bounce setting can be imported with "default", or the subuser name when the provider sets a subuser, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_forward_bounce.default default
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSendgridMailSettingsForwardBounce() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridMailSettingsForwardBounceUpdate,
+		ReadContext:   resourceSendgridMailSettingsForwardBounceRead,
+		UpdateContext: resourceSendgridMailSettingsForwardBounceUpdate,
+		DeleteContext: mailSettingDelete(sendgrid.MailSettingForwardBounce),
+		Importer: &schema.ResourceImporter{
+			StateContext: importSingleton,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the bounce reports are forwarded.",
+				Required:    true,
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The email the bounce reports are forwarded to. They are sent to the email of the account when empty.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceSendgridMailSettingsForwardBounceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting := sendgrid.MailSettingsForwardBounce{
+		Enabled: d.Get("enabled").(bool),
+		Email:   d.Get("email").(string),
+	}
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, singletonTimeout(d), func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateMailSettingsForwardBounce(ctx, setting)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(singletonID(c))
+
+	return resourceSendgridMailSettingsForwardBounceRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsForwardBounceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting, err := c.ReadMailSettingsForwardBounce(ctx)
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_mail_settings_forward_bounce", err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("email", setting.Email)
+
+	return nil
+}
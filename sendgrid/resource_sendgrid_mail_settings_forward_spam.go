@@ -0,0 +1,111 @@
+/*
+Provide a resource to manage the addresses the spam reports are forwarded to.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_forward_spam" "default" {
+		enabled = true
+		emails  = ["abuse@example.com", "postmaster@example.com"]
+	}
+
+```
+Destroying the resource disables the setting, and leaves its emails as is.
+Import
+The forward spam setting can be imported with "default", or the subuser name when the provider sets a subuser, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_forward_spam.default default
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSendgridMailSettingsForwardSpam() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridMailSettingsForwardSpamUpdate,
+		ReadContext:   resourceSendgridMailSettingsForwardSpamRead,
+		UpdateContext: resourceSendgridMailSettingsForwardSpamUpdate,
+		DeleteContext: mailSettingDelete(sendgrid.MailSettingForwardSpam),
+		Importer: &schema.ResourceImporter{
+			StateContext: importSingleton,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the spam reports are forwarded.",
+				Required:    true,
+			},
+			"emails": {
+				Type: schema.TypeSet,
+				Description: "The emails the spam reports are forwarded to. " +
+					"They are sent to the email of the account when empty.",
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceSendgridMailSettingsForwardSpamUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	// SendGrid takes the emails as a comma-separated list.
+	emails := emailList(d.Get("emails"))
+	sort.Strings(emails)
+
+	setting := sendgrid.MailSettingsForwardSpam{
+		Enabled: d.Get("enabled").(bool),
+		Email:   strings.Join(emails, ","),
+	}
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, singletonTimeout(d), func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateMailSettingsForwardSpam(ctx, setting)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(singletonID(c))
+
+	return resourceSendgridMailSettingsForwardSpamRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsForwardSpamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting, err := c.ReadMailSettingsForwardSpam(ctx)
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_mail_settings_forward_spam", err.Err)
+	}
+
+	emails := []string{}
+
+	for _, email := range strings.Split(setting.Email, ",") {
+		if email = strings.TrimSpace(email); email != "" {
+			emails = append(emails, email)
+		}
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("emails", emails)
+
+	return nil
+}
@@ -0,0 +1,101 @@
+/*
+Provide a resource to manage the legacy HTML template wrapping every email.
+Example Usage
+```hcl
+
+	resource "sendgrid_mail_settings_template" "default" {
+		enabled      = true
+		html_content = "<html><body><% body %><p>Example Inc</p></body></html>"
+	}
+
+```
+Destroying the resource disables the template, and leaves its content as is.
+Import
+The template setting can be imported with "default", or the subuser name when the provider sets a subuser, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_template.default default
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"regexp"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// templateBodyTag is the tag replaced by the content of the email in a legacy template.
+var templateBodyTag = regexp.MustCompile(`<%\s*body\s*%>`)
+
+func resourceSendgridMailSettingsTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridMailSettingsTemplateUpdate,
+		ReadContext:   resourceSendgridMailSettingsTemplateRead,
+		UpdateContext: resourceSendgridMailSettingsTemplateUpdate,
+		DeleteContext: mailSettingDelete(sendgrid.MailSettingTemplate),
+		Importer: &schema.ResourceImporter{
+			StateContext: importSingleton,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the emails are wrapped in the template.",
+				Required:    true,
+			},
+			"html_content": {
+				Type:         schema.TypeString,
+				Description:  "The HTML of the template. The <% body %> tag is replaced by the content of the email.",
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(templateBodyTag, "must contain the <% body %> tag"),
+			},
+		},
+	}
+}
+
+func resourceSendgridMailSettingsTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting := sendgrid.MailSettingsTemplate{
+		Enabled:     d.Get("enabled").(bool),
+		HTMLContent: d.Get("html_content").(string),
+	}
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, singletonTimeout(d), func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateMailSettingsTemplate(ctx, setting)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(singletonID(c))
+
+	return resourceSendgridMailSettingsTemplateRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting, err := c.ReadMailSettingsTemplate(ctx)
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_mail_settings_template", err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("html_content", setting.HTMLContent)
+
+	return nil
+}
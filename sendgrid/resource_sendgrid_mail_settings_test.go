@@ -0,0 +1,182 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitSendgridMailSettings(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	config := testUnitProviderConfig(server) + `
+resource "sendgrid_mail_settings_footer" "test" {
+  enabled       = true
+  html_content  = "<p>Example Inc</p>"
+  plain_content = "Example Inc"
+}
+
+resource "sendgrid_mail_settings_bcc" "test" {
+  enabled = true
+  email   = "archive@example.com"
+}
+
+resource "sendgrid_mail_settings_bounce_purge" "test" {
+  enabled      = true
+  hard_bounces = 90
+}
+
+resource "sendgrid_mail_settings_forward_bounce" "test" {
+  enabled = true
+  email   = "bounces@example.com"
+}
+
+resource "sendgrid_mail_settings_forward_spam" "test" {
+  enabled = true
+  emails  = ["postmaster@example.com", "abuse@example.com"]
+}
+
+resource "sendgrid_mail_settings_address_allowlist" "test" {
+  enabled = true
+  entries = ["example.com", "ceo@partner.example"]
+}
+
+resource "sendgrid_mail_settings_template" "test" {
+  enabled      = false
+  html_content = "<html><% body %></html>"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testUnitCheckMailSettingsDisabled,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_footer.test", "id", "default"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_footer.test", "html_content", "<p>Example Inc</p>"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bounce_purge.test", "hard_bounces", "90"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bounce_purge.test", "soft_bounces", "0"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_forward_spam.test", "emails.#", "2"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_address_allowlist.test", "entries.#", "2"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_template.test", "enabled", "false"),
+					testUnitCheckForwardSpamEmail("abuse@example.com,postmaster@example.com"),
+				),
+			},
+			{
+				// A change made outside of Terraform is reverted.
+				PreConfig: func() {
+					_, err := server.Client().UpdateMailSettingsFooter(context.Background(), sendgrid.MailSettingsFooter{
+						Enabled: false, HTMLContent: "<p>Changed</p>",
+					})
+					if err.Err != nil {
+						t.Fatalf("failed changing the footer: %v", err.Err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_footer.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_footer.test", "html_content", "<p>Example Inc</p>"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_footer.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_bcc.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_bounce_purge.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_forward_bounce.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_forward_spam.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_address_allowlist.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "sendgrid_mail_settings_footer.test",
+				ImportState:   true,
+				ImportStateId: "someone",
+				ExpectError:   regexp.MustCompile(`A setting is imported with "default"`),
+			},
+		},
+	})
+}
+
+func testUnitCheckForwardSpamEmail(want string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		setting, err := c.ReadMailSettingsForwardSpam(context.Background())
+		if err.Err != nil {
+			return err.Err
+		}
+
+		if setting.Email != want {
+			return fmt.Errorf("forward spam email = %q, want %q", setting.Email, want)
+		}
+
+		return nil
+	}
+}
+
+// testUnitCheckMailSettingsDisabled checks that the destroyed mail settings are disabled.
+func testUnitCheckMailSettingsDisabled(*terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+	ctx := context.Background()
+
+	footer, err := c.ReadMailSettingsFooter(ctx)
+	if err.Err != nil {
+		return err.Err
+	}
+
+	bcc, err := c.ReadMailSettingsBCC(ctx)
+	if err.Err != nil {
+		return err.Err
+	}
+
+	allowlist, err := c.ReadMailSettingsAddressAllowlist(ctx)
+	if err.Err != nil {
+		return err.Err
+	}
+
+	if footer.Enabled || bcc.Enabled || allowlist.Enabled {
+		return fmt.Errorf("mail settings still enabled: footer %t, bcc %t, allowlist %t", footer.Enabled, bcc.Enabled, allowlist.Enabled)
+	}
+
+	if footer.HTMLContent != "<p>Example Inc</p>" {
+		return fmt.Errorf("footer html_content = %q, want it left as is", footer.HTMLContent)
+	}
+
+	return nil
+}
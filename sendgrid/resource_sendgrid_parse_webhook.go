@@ -37,6 +37,13 @@ func resourceSendgridParseWebhook() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"hostname": {
 				Type: schema.TypeString,
@@ -78,7 +85,7 @@ func resourceSendgridParseWebhookCreate(ctx context.Context, d *schema.ResourceD
 	spamCheck := d.Get("spam_check").(bool)
 	sendRaw := d.Get("send_raw").(bool)
 
-	parseWebhookStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	parseWebhookStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, sendgrid.RequestError) {
 		return c.CreateParseWebhook(ctx, hostname, url, spamCheck, sendRaw)
 	})
 
@@ -98,7 +105,7 @@ func resourceSendgridParseWebhookRead(ctx context.Context, d *schema.ResourceDat
 
 	webhook, err := c.ReadParseWebhook(ctx, d.Id())
 	if err.Err != nil {
-		return diag.FromErr(err.Err)
+		return readDiagnostics(ctx, d, "sendgrid_parse_webhook", err.Err)
 	}
 
 	//nolint:errcheck
@@ -119,7 +126,7 @@ func resourceSendgridParseWebhookUpdate(ctx context.Context, d *schema.ResourceD
 	spamCheck := d.Get("spam_check").(bool)
 	sendRaw := d.Get("send_raw").(bool)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
 		return nil, c.UpdateParseWebhook(ctx, d.Id(), spamCheck, sendRaw)
 	})
 	if err != nil {
@@ -132,7 +139,7 @@ func resourceSendgridParseWebhookUpdate(ctx context.Context, d *schema.ResourceD
 func resourceSendgridParseWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, sendgrid.RequestError) {
 		return c.DeleteParseWebhook(ctx, d.Id())
 	})
 	if err != nil {
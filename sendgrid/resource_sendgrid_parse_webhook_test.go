@@ -16,9 +16,9 @@ func TestAccSendgridParseWebhookBasic(t *testing.T) {
 	url := "https://example-" + acctest.RandString(10) + ".com/parse"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckSendgridParseWebhookDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridParseWebhookDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckSendgridParseWebhookConfigBasic(hostname, url),
@@ -38,9 +38,9 @@ func TestAccSendgridParseWebhookWithSendRaw(t *testing.T) {
 	url := "https://raw-" + acctest.RandString(10) + ".com/parse"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckSendgridParseWebhookDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridParseWebhookDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckSendgridParseWebhookConfigWithSendRaw(hostname, url),
@@ -62,9 +62,9 @@ func TestAccSendgridParseWebhookUpdate(t *testing.T) {
 	urlUpdated := "https://updated-" + acctest.RandString(10) + ".com/parse"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckSendgridParseWebhookDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridParseWebhookDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckSendgridParseWebhookConfigBasic(hostname, url),
@@ -89,9 +89,9 @@ func TestAccSendgridParseWebhookWithRateLimiting(t *testing.T) {
 	url := "https://rate-limit-" + acctest.RandString(10) + ".com/parse"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckSendgridParseWebhookDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridParseWebhookDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckSendgridParseWebhookConfigWithTimeouts(hostname, url),
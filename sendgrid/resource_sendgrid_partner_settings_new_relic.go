@@ -0,0 +1,115 @@
+/*
+Provide a resource to manage the New Relic account the statistics of the emails are sent to.
+Example Usage
+```hcl
+
+	resource "sendgrid_partner_settings_new_relic" "default" {
+		enabled                   = true
+		license_key               = var.new_relic_license_key
+		enable_subuser_statistics = true
+	}
+
+```
+Destroying the resource stops sending the statistics to New Relic, and leaves the license key as is.
+Import
+The New Relic partner setting can be imported with "default", or the subuser name when the provider sets a subuser, e.g.
+```hcl
+$ terraform import sendgrid_partner_settings_new_relic.default default
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSendgridPartnerSettingsNewRelic() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridPartnerSettingsNewRelicUpdate,
+		ReadContext:   resourceSendgridPartnerSettingsNewRelicRead,
+		UpdateContext: resourceSendgridPartnerSettingsNewRelicUpdate,
+		DeleteContext: settingDelete(func(ctx context.Context, c *sendgrid.Client) (bool, sendgrid.RequestError) {
+			return c.DisablePartnerSettingsNewRelic(ctx)
+		}),
+		Importer: &schema.ResourceImporter{
+			StateContext: importSingleton,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the statistics are sent to New Relic.",
+				Required:    true,
+			},
+			"license_key": {
+				Type:        schema.TypeString,
+				Description: "The license key of the New Relic account.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"enable_subuser_statistics": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the statistics of the subusers are sent too.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceSendgridPartnerSettingsNewRelicUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting := sendgrid.PartnerSettingsNewRelic{
+		Enabled:                 d.Get("enabled").(bool),
+		LicenseKey:              d.Get("license_key").(string),
+		EnableSubuserStatistics: d.Get("enable_subuser_statistics").(bool),
+	}
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, singletonTimeout(d), func() (interface{}, sendgrid.RequestError) {
+		return c.UpdatePartnerSettingsNewRelic(ctx, setting)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(singletonID(c))
+
+	return resourceSendgridPartnerSettingsNewRelicRead(ctx, d, m)
+}
+
+func resourceSendgridPartnerSettingsNewRelicRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting, err := c.ReadPartnerSettingsNewRelic(ctx)
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_partner_settings_new_relic", err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("license_key", setting.LicenseKey)
+	//nolint:errcheck
+	d.Set("enable_subuser_statistics", setting.EnableSubuserStatistics)
+
+	return nil
+}
@@ -0,0 +1,223 @@
+/*
+Provide a resource to manage the reverse DNS of a dedicated IP address.
+Example Usage
+```hcl
+
+	resource "sendgrid_reverse_dns" "default" {
+		ip        = "192.0.2.10"
+		domain    = "example.com"
+		subdomain = "mail"
+	}
+
+```
+Import
+A reverse DNS can be imported by its ID, e.g.
+```hcl
+$ terraform import sendgrid_reverse_dns.default reverseDnsId
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSendgridReverseDNS() *schema.Resource { //nolint:funlen
+	return &schema.Resource{
+		CreateContext: resourceSendgridReverseDNSCreate,
+		ReadContext:   resourceSendgridReverseDNSRead,
+		UpdateContext: resourceSendgridReverseDNSUpdate,
+		DeleteContext: resourceSendgridReverseDNSDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:         schema.TypeString,
+				Description:  "The dedicated IP address to set the reverse DNS of.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "The root, or sending, domain the IP address resolves to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"subdomain": {
+				Type:        schema.TypeString,
+				Description: "The subdomain of the domain the IP address resolves to.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"rdns": {
+				Type:        schema.TypeString,
+				Description: "The fully qualified domain name the IP address resolves to.",
+				Computed:    true,
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Description: "The usernames of the users sending from the IP address.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"legacy": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if this reverse DNS was created with the legacy whitelabel API.",
+				Computed:    true,
+			},
+			"valid": {
+				Type: schema.TypeBool,
+				Description: "Indicates if this is a valid reverse DNS or not. " +
+					"Set to `true` to attempt validation on first update.",
+				Optional: true,
+				Computed: true,
+			},
+			"a_record": {
+				Type:        schema.TypeList,
+				Description: "The A record to publish for the reverse DNS.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"valid": {
+							Type:        schema.TypeBool,
+							Description: "Indicates if this is a valid A record.",
+							Computed:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "The type of DNS record.",
+							Computed:    true,
+						},
+						"host": {
+							Type:        schema.TypeString,
+							Description: "The domain that this A record is created for.",
+							Computed:    true,
+						},
+						"data": {
+							Type:        schema.TypeString,
+							Description: "The actual DNS record.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSendgridReverseDNSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	ip := d.Get("ip").(string)
+	domain := d.Get("domain").(string)
+	subdomain := d.Get("subdomain").(string)
+
+	rdnsStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, sendgrid.RequestError) {
+		return c.CreateReverseDNS(ctx, ip, domain, subdomain)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprint(rdnsStruct.(*sendgrid.ReverseDNS).ID))
+
+	return resourceSendgridReverseDNSRead(ctx, d, m)
+}
+
+func resourceSendgridReverseDNSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	rdns, err := c.ReadReverseDNS(ctx, d.Id())
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_reverse_dns", err.Err)
+	}
+
+	users := make([]string, 0, len(rdns.Users))
+	for _, user := range rdns.Users {
+		users = append(users, user.Username)
+	}
+
+	//nolint:errcheck
+	d.Set("ip", rdns.IP)
+	//nolint:errcheck
+	d.Set("domain", rdns.Domain)
+	//nolint:errcheck
+	d.Set("subdomain", rdns.Subdomain)
+	//nolint:errcheck
+	d.Set("rdns", rdns.RDNS)
+	//nolint:errcheck
+	d.Set("users", users)
+	//nolint:errcheck
+	d.Set("legacy", rdns.Legacy)
+	//nolint:errcheck
+	d.Set("valid", rdns.Valid)
+
+	aRecord := make([]interface{}, 0)
+	if rdns.ARecord.Type != "" {
+		aRecord = append(aRecord, map[string]interface{}{
+			"type":  rdns.ARecord.Type,
+			"valid": rdns.ARecord.Valid,
+			"host":  rdns.ARecord.Host,
+			"data":  rdns.ARecord.Data,
+		})
+	}
+
+	if er := d.Set("a_record", aRecord); er != nil {
+		return diag.FromErr(er)
+	}
+
+	return nil
+}
+
+// resourceSendgridReverseDNSUpdate validates the reverse DNS: the other arguments can't be changed.
+func resourceSendgridReverseDNSUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	rdnsStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
+		return c.ReadReverseDNS(ctx, d.Id())
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !rdnsStruct.(*sendgrid.ReverseDNS).Valid && d.Get("valid").(bool) {
+		_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
+			return c.ValidateReverseDNS(ctx, d.Id())
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSendgridReverseDNSRead(ctx, d, m)
+}
+
+func resourceSendgridReverseDNSDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteReverseDNS(ctx, d.Id())
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
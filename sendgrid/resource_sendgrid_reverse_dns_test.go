@@ -0,0 +1,88 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitSendgridReverseDNS(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	domain := "rdns-" + acctest.RandString(10) + ".example.com"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridReverseDNSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + testAccCheckSendgridReverseDNSConfig("192.0.2.10", domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.test", "rdns", "mail."+domain),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.test", "valid", "false"),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.test", "a_record.#", "1"),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.test", "a_record.0.type", "a"),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.test", "a_record.0.host", "mail."+domain),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.test", "a_record.0.data", "192.0.2.10"),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.test", "a_record.0.valid", "false"),
+				),
+			},
+			{
+				// Setting valid validates the published A record, without recreating the reverse DNS.
+				Config: testUnitProviderConfig(server) + testAccCheckSendgridReverseDNSConfig("192.0.2.10", domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.test", "valid", "true"),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.test", "a_record.0.valid", "true"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_reverse_dns.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// The reverse DNS of another IP address is a new one.
+				Config: testUnitProviderConfig(server) + testAccCheckSendgridReverseDNSConfig("192.0.2.11", domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.test", "a_record.0.data", "192.0.2.11"),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.test", "valid", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridReverseDNSDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_reverse_dns" {
+			continue
+		}
+
+		if _, err := c.ReadReverseDNS(context.Background(), rs.Primary.ID); err.StatusCode != 404 {
+			return fmt.Errorf("reverse DNS still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridReverseDNSConfig(ip, domain string, valid bool) string {
+	return fmt.Sprintf(`
+resource "sendgrid_reverse_dns" "test" {
+	ip        = %q
+	domain    = %q
+	subdomain = "mail"
+	valid     = %t
+}
+`, ip, domain, valid)
+}
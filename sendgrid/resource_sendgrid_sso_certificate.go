@@ -47,6 +47,13 @@ func resourceSendgridSSOCertificate() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"public_certificate": {
 				Type: schema.TypeString,
@@ -69,7 +76,7 @@ func resourceSendgridSSOCertificateCreate(ctx context.Context, d *schema.Resourc
 	publicCertificate := d.Get("public_certificate").(string)
 	integrationID := d.Get("integration_id").(string)
 
-	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, sendgrid.RequestError) {
 		return c.CreateSSOCertificate(ctx, publicCertificate, integrationID)
 	})
 	if err != nil {
@@ -89,7 +96,7 @@ func resourceSendgridSSOCertificateRead(ctx context.Context, d *schema.ResourceD
 	certificate, requestErr := c.ReadSSOCertificate(ctx, d.Id())
 
 	if requestErr.Err != nil {
-		return diag.FromErr(requestErr.Err)
+		return readDiagnostics(ctx, d, "sendgrid_sso_certificate", requestErr.Err)
 	}
 
 	//nolint:errcheck
@@ -107,7 +114,7 @@ func resourceSendgridSSOCertificateUpdate(ctx context.Context, d *schema.Resourc
 	publicCertificate := d.Get("public_certificate").(string)
 	integrationID := d.Get("integration_id").(string)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
 		return c.UpdateSSOCertificate(ctx, id, publicCertificate, integrationID)
 	})
 	if err != nil {
@@ -120,7 +127,7 @@ func resourceSendgridSSOCertificateUpdate(ctx context.Context, d *schema.Resourc
 func resourceSendgridSSOCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, sendgrid.RequestError) {
 		return c.DeleteSSOCertificate(ctx, fmt.Sprint(d.Id()))
 	})
 	if err != nil {
@@ -41,6 +41,13 @@ func resourceSendgridSSOIntegration() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -102,7 +109,7 @@ func resourceSendgridSSOIntegrationCreate(ctx context.Context, d *schema.Resourc
 	signOutURL := d.Get("signout_url").(string)
 	entityID := d.Get("entity_id").(string)
 
-	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, sendgrid.RequestError) {
 		return c.CreateSSOIntegration(ctx, name, enabled, signInURL, signOutURL, entityID)
 	})
 	if err != nil {
@@ -122,7 +129,7 @@ func resourceSendgridSSOIntegrationRead(ctx context.Context, d *schema.ResourceD
 	integration, requestErr := c.ReadSSOIntegration(ctx, d.Id())
 
 	if requestErr.Err != nil {
-		return diag.FromErr(requestErr.Err)
+		return readDiagnostics(ctx, d, "sendgrid_sso_integration", requestErr.Err)
 	}
 
 	//nolint:errcheck
@@ -155,7 +162,7 @@ func resourceSendgridSSOIntegrationUpdate(ctx context.Context, d *schema.Resourc
 	signOutURL := d.Get("signout_url").(string)
 	entityID := d.Get("entity_id").(string)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
 		return c.UpdateSSOIntegration(ctx, id, name, enabled, signInURL, signOutURL, entityID)
 	})
 	if err != nil {
@@ -168,7 +175,7 @@ func resourceSendgridSSOIntegrationUpdate(ctx context.Context, d *schema.Resourc
 func resourceSendgridSSOIntegrationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, sendgrid.RequestError) {
 		return c.DeleteSSOIntegration(ctx, d.Id())
 	})
 	if err != nil {
@@ -39,6 +39,13 @@ func resourceSendgridSubuser() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"username": {
 				Type:        schema.TypeString,
@@ -101,7 +108,7 @@ func resourceSendgridSubuserCreate(ctx context.Context, d *schema.ResourceData,
 		ips = append(ips, ip.(string))
 	}
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, sendgrid.RequestError) {
 		return c.CreateSubuser(ctx, username, email, password, ips)
 	})
 	if err != nil {
@@ -126,11 +133,11 @@ func resourceSendgridSubuserRead(ctx context.Context, d *schema.ResourceData, m
 
 	subUser, requestErr := c.ReadSubUser(ctx, d.Id())
 	if requestErr.Err != nil {
-		return diag.FromErr(requestErr.Err)
+		return readDiagnostics(ctx, d, "sendgrid_subuser", requestErr.Err)
 	}
 
 	if len(subUser) == 0 {
-		return diag.FromErr(subUserNotFound(d.Id()))
+		return readDiagnostics(ctx, d, "sendgrid_subuser", subUserNotFound(d.Id()))
 	}
 
 	//nolint:errcheck
@@ -184,7 +191,7 @@ func resourceSendgridSubuserUpdate(ctx context.Context, d *schema.ResourceData,
 func resourceSendgridSubuserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, sendgrid.RequestError) {
 		return c.DeleteSubuser(ctx, d.Id())
 	})
 	if err != nil {
@@ -0,0 +1,94 @@
+/*
+Provide a resource to remove emails from a suppression list, so that SendGrid delivers to them again.
+Example Usage
+```hcl
+
+	resource "sendgrid_suppression_removal" "fixed_mailbox" {
+		list   = "bounces"
+		emails = ["support@example.com"]
+
+		triggers = {
+			ticket = "OPS-1234"
+		}
+	}
+
+```
+The emails are removed once, when the resource is created. Change the triggers to remove
+them again. Destroying the resource doesn't add them back.
+*/
+package sendgrid
+
+import (
+	"context"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSendgridSuppressionRemoval() *schema.Resource {
+	lists := make([]string, 0, len(sendgrid.SuppressionLists()))
+	for _, list := range sendgrid.SuppressionLists() {
+		lists = append(lists, string(list))
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceSendgridSuppressionRemovalCreate,
+		ReadContext:   schema.NoopContext,
+		DeleteContext: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"list": {
+				Type:         schema.TypeString,
+				Description:  "The suppression list to remove the emails from: bounces, blocks, spam_reports or invalid_emails.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(lists, false),
+			},
+			"emails": {
+				Type:        schema.TypeSet,
+				Description: "The emails to remove from the list. The emails missing from the list are ignored.",
+				Required:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, maxEmailLength),
+				},
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values that remove the emails again when they change.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceSendgridSuppressionRemovalCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	list := sendgrid.SuppressionList(d.Get("list").(string))
+	emails := emailList(d.Get("emails"))
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteSuppressionListEntries(ctx, list, emails)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, "Removed emails from suppression list", map[string]interface{}{"list": string(list), "count": len(emails)})
+
+	d.SetId(id.UniqueId())
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitDataSourceSendgridSuppressionLists(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	january := time.Date(2026, time.January, 10, 12, 0, 0, 0, time.UTC)
+	march := time.Date(2026, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	server.AddSuppressionListEntry("bounces", "old@example.com", january)
+	server.AddSuppressionListEntry("bounces", "new@example.com", march)
+	server.AddSuppressionListEntry("blocks", "blocked@example.com", march)
+	server.AddSuppressionListEntry("spam_reports", "angry@example.com", march)
+	server.AddSuppressionListEntry("invalid_emails", "typo@example.con", march)
+	server.AddSuppressionListEntry("invalid_emails", testUnitLongEmail, march)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + fmt.Sprintf(`
+data "sendgrid_bounces" "all" {}
+
+data "sendgrid_bounces" "recent" {
+  start_time = "2026-02-01T00:00:00Z"
+}
+
+data "sendgrid_bounces" "old" {
+  end_time = "2026-02-01T00:00:00Z"
+}
+
+data "sendgrid_bounces" "email" {
+  email = "new@example.com"
+}
+
+data "sendgrid_blocks" "all" {}
+
+data "sendgrid_spam_reports" "all" {}
+
+data "sendgrid_invalid_emails" "all" {}
+
+data "sendgrid_invalid_emails" "long" {
+  email = %q
+}
+`, testUnitLongEmail),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.all", "emails.#", "2"),
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.all", "entries.0.email", "old@example.com"),
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.all", "entries.0.created", "2026-01-10T12:00:00Z"),
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.all", "entries.0.status", "5.1.1"),
+					resource.TestCheckResourceAttrSet("data.sendgrid_bounces.all", "entries.0.reason"),
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.recent", "emails.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.recent", "emails.0", "new@example.com"),
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.old", "emails.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.old", "emails.0", "old@example.com"),
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.email", "emails.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_blocks.all", "emails.0", "blocked@example.com"),
+					resource.TestCheckResourceAttr("data.sendgrid_spam_reports.all", "entries.0.ip", "192.0.2.1"),
+					resource.TestCheckNoResourceAttr("data.sendgrid_spam_reports.all", "entries.0.reason"),
+					resource.TestCheckResourceAttr("data.sendgrid_invalid_emails.all", "emails.#", "2"),
+					resource.TestCheckResourceAttr("data.sendgrid_invalid_emails.long", "emails.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_invalid_emails.long", "emails.0", testUnitLongEmail),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourceSendgridSuppressionListsPagination(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	const count = 501
+
+	for i := range count {
+		server.AddSuppressionListEntry("bounces", fmt.Sprintf("user%d@example.com", i), time.Now())
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + `
+data "sendgrid_bounces" "all" {}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.all", "emails.#", fmt.Sprint(count)),
+					resource.TestCheckResourceAttr("data.sendgrid_bounces.all", "emails.500", "user500@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitSendgridSuppressionRemoval(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	for _, email := range []string{"support@example.com", "sales@example.com", "other@example.com", testUnitLongEmail} {
+		server.AddSuppressionListEntry("bounces", email, time.Now())
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + testUnitSendgridSuppressionRemovalConfig("OPS-1"),
+				Check:  testUnitCheckSuppressionList(sendgrid.SuppressionBounces, "other@example.com"),
+			},
+			{
+				// The emails bounce again: the removal isn't repeated until the triggers change.
+				PreConfig: func() {
+					server.AddSuppressionListEntry("bounces", "support@example.com", time.Now())
+				},
+				Config: testUnitProviderConfig(server) + testUnitSendgridSuppressionRemovalConfig("OPS-1"),
+				Check:  testUnitCheckSuppressionList(sendgrid.SuppressionBounces, "other@example.com", "support@example.com"),
+			},
+			{
+				Config: testUnitProviderConfig(server) + testUnitSendgridSuppressionRemovalConfig("OPS-2"),
+				Check:  testUnitCheckSuppressionList(sendgrid.SuppressionBounces, "other@example.com"),
+			},
+		},
+	})
+}
+
+func testUnitSendgridSuppressionRemovalConfig(ticket string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_suppression_removal" "test" {
+  list   = "bounces"
+  emails = ["support@example.com", "sales@example.com", "unknown@example.com", %q]
+
+  triggers = {
+    ticket = %q
+  }
+}
+`, testUnitLongEmail, ticket)
+}
+
+func testUnitCheckSuppressionList(list sendgrid.SuppressionList, want ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		entries, err := c.ReadSuppressionList(context.Background(), list, sendgrid.SuppressionListFilter{})
+		if err.Err != nil {
+			return err.Err
+		}
+
+		got := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			got = append(got, entry.Email)
+		}
+
+		slices.Sort(got)
+
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("%s = %v, want %v", list, got, want)
+		}
+
+		return nil
+	}
+}
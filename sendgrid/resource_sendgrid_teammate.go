@@ -17,16 +17,23 @@ package sendgrid
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
-	"github.com/hashicorp/go-cty/cty"
-	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // validSendgridScopes contains the actual list of valid SendGrid scopes
@@ -301,8 +308,49 @@ var sendgridAutomaticScopes = map[string]bool{
 	"sender_verification_legacy": true, // SendGrid manages this scope automatically
 }
 
-func resourceSendgridTeammate() *schema.Resource {
-	return &schema.Resource{
+var (
+	_ resource.ResourceWithConfigure   = &teammateResource{}
+	_ resource.ResourceWithImportState = &teammateResource{}
+)
+
+// teammateResource manages a teammate with the plugin framework.
+type teammateResource struct {
+	client *sendgrid.Client
+}
+
+func newTeammateResource() resource.Resource {
+	return &teammateResource{}
+}
+
+type teammateResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	Email      types.String   `tfsdk:"email"`
+	FirstName  types.String   `tfsdk:"first_name"`
+	LastName   types.String   `tfsdk:"last_name"`
+	IsAdmin    types.Bool     `tfsdk:"is_admin"`
+	IsSSO      types.Bool     `tfsdk:"is_sso"`
+	Scopes     types.Set      `tfsdk:"scopes"`
+	Username   types.String   `tfsdk:"username"`
+	UserStatus types.String   `tfsdk:"user_status"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+// optionalArguments returns the optional arguments, see configuredArguments.
+func (m *teammateResourceModel) optionalArguments() map[string]attr.Value {
+	return map[string]attr.Value{
+		"first_name": m.FirstName,
+		"last_name":  m.LastName,
+		"username":   m.Username,
+		"scopes":     m.Scopes,
+	}
+}
+
+func (r *teammateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teammate"
+}
+
+func (r *teammateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
 		Description: `Manages a SendGrid teammate. Teammates are team members who have access to your SendGrid account with specific permissions.
 
 **Important Notes:**
@@ -311,119 +359,108 @@ func resourceSendgridTeammate() *schema.Resource {
 - Some scopes require specific SendGrid plans (Pro+, Marketing plans, etc.)
 - Use timeouts for better reliability with rate limiting`,
 
-		CreateContext: resourceSendgridTeammateCreate,
-		ReadContext:   resourceSendgridTeammateRead,
-		UpdateContext: resourceSendgridTeammateUpdate,
-		DeleteContext: resourceSendgridTeammateDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
-		Schema: map[string]*schema.Schema{
-			"email": {
-				Type:        schema.TypeString,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
 				Description: "The email address of the teammate. This will be used as the teammate's login.",
 				Required:    true,
 			},
-			"first_name": {
-				Type:             schema.TypeString,
-				Description:      "The first name of the teammate. Required for SSO users.",
-				Optional:         true,
-				DiffSuppressFunc: suppressDiffForPendingUsers,
+			"first_name": schema.StringAttribute{
+				Description: "The first name of the teammate. Required for SSO users.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					nonSSONameModifier{},
+				},
 			},
-			"last_name": {
-				Type:             schema.TypeString,
-				Description:      "The last name of the teammate. Required for SSO users.",
-				Optional:         true,
-				DiffSuppressFunc: suppressDiffForPendingUsers,
+			"last_name": schema.StringAttribute{
+				Description: "The last name of the teammate. Required for SSO users.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					nonSSONameModifier{},
+				},
 			},
-			"is_admin": {
-				Type:        schema.TypeBool,
+			"is_admin": schema.BoolAttribute{
 				Description: "Whether the teammate should have admin privileges. Admin teammates have full access to the account and don't need specific scopes.",
 				Required:    true,
-				Elem: &schema.Schema{
-					Type: schema.TypeBool,
-				},
 			},
-			"is_sso": {
-				Type:        schema.TypeBool,
+			"is_sso": schema.BoolAttribute{
 				Description: "Whether this is a Single Sign-On (SSO) user. SSO users require first_name and last_name.",
 				Required:    true,
-				Elem: &schema.Schema{
-					Type: schema.TypeBool,
-				},
 			},
-			"scopes": {
-				Type:        schema.TypeSet,
+			"scopes": schema.SetAttribute{
 				Description: "List of permission scopes for the teammate. Ignored if is_admin is true. Cannot include '2fa_exempt' or '2fa_required' as these are managed automatically by SendGrid. See SendGrid API documentation for available scopes.",
 				Optional:    true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
+				ElementType: types.StringType,
 			},
-			"username": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Description:      "The username for the teammate. If not provided, the email will be used.",
-				DiffSuppressFunc: suppressDiffForPendingUsers,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Description: "The username for the teammate. If not provided, the email will be used.",
 			},
-			"user_status": {
-				Type:        schema.TypeString,
+			"user_status": schema.StringAttribute{
 				Computed:    true,
 				Description: "The status of the user: 'active' for confirmed users, 'pending' for users who haven't accepted their invitation yet.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
+func (r *teammateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = providerClient(req.ProviderData, &resp.Diagnostics)
+}
+
 // validateTeammateScopes validates the scopes provided for a teammate
-func validateTeammateScopes(v interface{}, path cty.Path) diag.Diagnostics {
+func validateTeammateScopes(scopes []string, attributePath path.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
-	scopes := v.(*schema.Set).List()
 
 	var invalidScopes []string
 	var automaticScopes []string
 
 	for _, scope := range scopes {
-		scopeStr := scope.(string)
-
 		// Check for automatic scopes that shouldn't be set manually
-		if sendgridAutomaticScopes[scopeStr] {
-			automaticScopes = append(automaticScopes, scopeStr)
+		if sendgridAutomaticScopes[scope] {
+			automaticScopes = append(automaticScopes, scope)
 			continue
 		}
 
 		// Check for invalid scopes
-		if !validSendgridScopes[scopeStr] {
-			invalidScopes = append(invalidScopes, scopeStr)
+		if !validSendgridScopes[scope] {
+			invalidScopes = append(invalidScopes, scope)
 		}
 	}
 
 	if len(automaticScopes) > 0 {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Automatic scopes cannot be manually assigned",
-			Detail: fmt.Sprintf(
+		diags.AddAttributeError(
+			attributePath,
+			"Automatic scopes cannot be manually assigned",
+			fmt.Sprintf(
 				"the following scopes are set automatically by SendGrid and cannot be manually assigned: %s",
 				strings.Join(automaticScopes, ", "),
 			),
-			AttributePath: path,
-		})
+		)
 	}
 
 	if len(invalidScopes) > 0 {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "Invalid or unassignable scopes",
-			Detail: fmt.Sprintf(
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid or unassignable scopes",
+			fmt.Sprintf(
 				"the following scopes are not valid or assignable: %s. Please check the SendGrid API documentation for valid scopes",
 				strings.Join(invalidScopes, ", "),
 			),
-			AttributePath: path,
-		})
+		)
 	}
 
 	return diags
@@ -440,30 +477,42 @@ func sanitizeScopes(scopes []string) []string {
 	return sanitized
 }
 
-// suppressDiffForPendingUsers suppresses diff for fields that are not available for pending users
-func suppressDiffForPendingUsers(k, old, new string, d *schema.ResourceData) bool {
-	userStatus := d.Get("user_status").(string)
-	isSSO := d.Get("is_sso").(bool)
+// nonSSONameModifier ignores the changes of the first and last names of teammates
+// that aren't SSO users: they can't be updated via the API, so the name returned
+// by SendGrid is kept.
+type nonSSONameModifier struct{}
+
+func (m nonSSONameModifier) Description(_ context.Context) string {
+	return "Keeps the name returned by SendGrid for teammates that aren't SSO users."
+}
+
+func (m nonSSONameModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
 
-	// For pending users, suppress diff if old value is empty and new value is set
-	// This prevents Terraform from showing changes for fields that can't be set until user accepts invitation
-	if userStatus == "pending" {
-		return old == "" && new != ""
+func (m nonSSONameModifier) PlanModifyString(
+	ctx context.Context,
+	req planmodifier.StringRequest,
+	resp *planmodifier.StringResponse,
+) {
+	// Terraform only accepts a plan ignoring the configuration when both values are set.
+	if req.StateValue.IsNull() || req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
 	}
 
-	// For non-SSO users, first_name and last_name cannot be updated via API
-	// Suppress diff when trying to change these fields from what API returned
-	if !isSSO && (k == "first_name" || k == "last_name") {
-		// Allow setting to what API returned (old value), but prevent changes
-		return old != new
+	var isSSO types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("is_sso"), &isSSO)...)
+
+	if isSSO.IsUnknown() || isSSO.ValueBool() {
+		return
 	}
 
-	return false
+	resp.PlanValue = req.StateValue
 }
 
 // enhancedRetryOnScopeErrors wraps the standard retry function with enhanced error handling for scope-related errors
-func enhancedRetryOnScopeErrors(ctx context.Context, d *schema.ResourceData, f func() (interface{}, sendgrid.RequestError)) (interface{}, error) {
-	resp, err := sendgrid.RetryOnRateLimit(ctx, d, f)
+func enhancedRetryOnScopeErrors(ctx context.Context, timeout time.Duration, f func() (interface{}, sendgrid.RequestError)) (interface{}, error) {
+	resp, err := sendgrid.RetryOnRateLimit(ctx, timeout, f)
 	if err != nil {
 		// Check if this is a scope-related error
 		if strings.Contains(err.Error(), "invalid or unassignable scopes") {
@@ -478,7 +527,7 @@ Tip: Use 'terraform plan' to validate your configuration before applying`, err)
 		}
 
 		// Check for user cancellation scenarios
-		if strings.Contains(err.Error(), "context canceled") || strings.Contains(err.Error(), "operation was canceled") {
+		if errors.Is(err, context.Canceled) {
 			return nil, fmt.Errorf(`operation was canceled. If you canceled the operation during execution, some resources may be in an intermediate state. Please check your SendGrid dashboard and run 'terraform refresh' to update the state.
 
 Original error: %w`, err)
@@ -487,80 +536,133 @@ Original error: %w`, err)
 	return resp, err
 }
 
-func resourceSendgridTeammateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*sendgrid.Client)
-	email := d.Get("email").(string)
-	isAdmin := d.Get("is_admin").(bool)
-	isSSO := d.Get("is_sso").(bool)
-	firstName := d.Get("first_name").(string)
-	lastName := d.Get("last_name").(string)
-
-	scopesSet := d.Get("scopes").(*schema.Set)
-
-	// Validate scopes if not admin
-	if !isAdmin && scopesSet.Len() > 0 {
-		path := cty.GetAttrPath("scopes")
-		if diags := validateTeammateScopes(scopesSet, path); diags.HasError() {
-			return diags
-		}
+// teammateScopes returns the validated scopes to send to SendGrid, none for admins.
+func teammateScopes(ctx context.Context, data *teammateResourceModel) ([]string, diag.Diagnostics) {
+	if data.IsAdmin.ValueBool() {
+		return nil, nil
 	}
 
 	var scopes []string
-	if !isAdmin {
-		scopesList := scopesSet.List()
-		for _, scope := range scopesList {
-			scopes = append(scopes, scope.(string))
+	diags := data.Scopes.ElementsAs(ctx, &scopes, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(scopes) > 0 {
+		if diags = validateTeammateScopes(scopes, path.Root("scopes")); diags.HasError() {
+			return nil, diags
 		}
-		// Sanitize scopes to remove any automatic ones
-		scopes = sanitizeScopes(scopes)
 	}
 
+	// Sanitize scopes to remove any automatic ones
+	return sanitizeScopes(scopes), nil
+}
+
+func (r *teammateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data teammateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	scopes, diags := teammateScopes(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := data.Email.ValueString()
+	isAdmin := data.IsAdmin.ValueBool()
+	isSSO := data.IsSSO.ValueBool()
+	firstName := data.FirstName.ValueString()
+	lastName := data.LastName.ValueString()
+
 	tflog.Debug(ctx, "Creating teammate", map[string]interface{}{
 		"first_name": firstName, "last_name": lastName,
 		"email": email, "is_admin": isAdmin, "scopes": scopes,
 	})
 
-	userStruct, err := enhancedRetryOnScopeErrors(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	userStruct, err := enhancedRetryOnScopeErrors(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
 		if isSSO {
-			return client.CreateSSOUser(ctx, firstName, lastName, email, scopes, isAdmin)
-		} else {
-			return client.CreateUser(ctx, email, scopes, isAdmin)
+			return r.client.CreateSSOUser(ctx, firstName, lastName, email, scopes, isAdmin)
 		}
+
+		return r.client.CreateUser(ctx, email, scopes, isAdmin)
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError(err.Error(), "")
+
+		return
 	}
 
 	user := userStruct.(*sendgrid.User)
-	d.SetId(user.Email)
-	if err := d.Set("email", user.Email); err != nil {
-		return diag.FromErr(err)
+	data.ID = types.StringValue(user.Email)
+	data.Email = types.StringValue(user.Email)
+
+	// SSO users are active right away, the others once they accept their invitation.
+	data.UserStatus = types.StringValue("pending")
+	if isSSO {
+		data.UserStatus = types.StringValue("active")
 	}
 
-	return nil
+	resp.Diagnostics.Append(configuredArgumentsOf(data.optionalArguments()).save(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func resourceSendgridTeammateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*sendgrid.Client)
+func (r *teammateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data teammateResourceModel
 
-	var diags diag.Diagnostics
-	email := d.Id()
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	// The SDK provider stored the names of the teammates that aren't SSO users even when they weren't configured.
+	sdkArguments := data.optionalArguments()
+	if !data.IsSSO.ValueBool() {
+		delete(sdkArguments, "first_name")
+		delete(sdkArguments, "last_name")
+	}
+
+	configured, diags := readConfiguredArguments(ctx, req.Private, sdkArguments)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := data.ID.ValueString()
 
-	teammateStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return client.ReadUser(ctx, email)
+	teammateStruct, err := sendgrid.RetryOnRateLimit(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+		return r.client.ReadUser(ctx, email)
 	})
 	if err != nil {
-		return append(diags, diag.FromErr(err)...)
+		readNotFound(ctx, resp, "sendgrid_teammate", email, err)
+
+		return
 	}
 
-	teammate := teammateStruct.(*sendgrid.User)
+	resp.Diagnostics.Append(data.setUser(teammateStruct.(*sendgrid.User), configured)...)
+	resp.Diagnostics.Append(configured.save(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
 
+// setUser sets the attributes read from SendGrid.
+func (m *teammateResourceModel) setUser(teammate *sendgrid.User, configured configuredArguments) diag.Diagnostics {
 	// There is no need to track admin scopes since they have full access.
 	if teammate.IsAdmin {
 		teammate.Scopes = nil
 	}
 
-	var filteredScopes []string
+	filteredScopes := []string{}
 	for _, s := range teammate.Scopes {
 		// Sendgrid sets these scopes automatically. If you try to set them, you will get a 400 error.
 		if !sendgridAutomaticScopes[s] {
@@ -577,91 +679,114 @@ func resourceSendgridTeammateRead(ctx context.Context, d *schema.ResourceData, m
 		userStatus = "pending"
 	}
 
-	d.SetId(teammate.Email)
-
-	// Always set all fields that API returns, regardless of user type
-	// DiffSuppressFunc will handle preventing unwanted changes
-	retErr := multierror.Append(
-		d.Set("email", teammate.Email),
-		d.Set("username", teammate.Username),
-		d.Set("first_name", teammate.FirstName),
-		d.Set("last_name", teammate.LastName),
-		d.Set("scopes", filteredScopes),
-		d.Set("is_admin", teammate.IsAdmin),
-		d.Set("user_status", userStatus),
-	)
-
-	return diag.FromErr(retErr.ErrorOrNil())
+	scopes, diags := configured.stringSet("scopes", filteredScopes)
+
+	m.ID = types.StringValue(teammate.Email)
+	m.Email = types.StringValue(teammate.Email)
+	m.Username = pendingName(m.Username, configured.string("username", teammate.Username), userStatus)
+	m.FirstName = pendingName(m.FirstName, configured.string("first_name", teammate.FirstName), userStatus)
+	m.LastName = pendingName(m.LastName, configured.string("last_name", teammate.LastName), userStatus)
+	m.Scopes = scopes
+	m.IsAdmin = types.BoolValue(teammate.IsAdmin)
+	m.UserStatus = types.StringValue(userStatus)
+
+	return diags
+}
+
+// pendingName keeps the configured name of a pending user:
+// SendGrid only returns it once the invitation is accepted.
+func pendingName(prior, name types.String, userStatus string) types.String {
+	if userStatus == "pending" && !name.IsNull() && name.ValueString() == "" {
+		return prior
+	}
+
+	return name
 }
 
-func resourceSendgridTeammateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*sendgrid.Client)
-	email := d.Get("email").(string)
-	isAdmin := d.Get("is_admin").(bool)
-	isSSO := d.Get("is_sso").(bool)
-	firstName := d.Get("first_name").(string)
-	lastName := d.Get("last_name").(string)
+func (r *teammateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state teammateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	email := data.Email.ValueString()
+	data.ID = state.ID
+	data.UserStatus = state.UserStatus
 
 	// Check if user is pending - pending users are read-only after invitation is sent
-	userStatus := d.Get("user_status").(string)
-	if userStatus == "pending" {
+	if state.UserStatus.ValueString() == "pending" {
 		tflog.Info(ctx, "Pending user detected - skipping update. Pending users are read-only until they accept their invitation", map[string]interface{}{
 			"email": email,
 		})
-		// For pending users, we only refresh their current state
-		return resourceSendgridTeammateRead(ctx, d, meta)
-	}
-
-	scopesSet := d.Get("scopes").(*schema.Set)
+	} else {
+		scopes, diags := teammateScopes(ctx, &data)
+		resp.Diagnostics.Append(diags...)
 
-	// Validate scopes if not admin
-	if !isAdmin && scopesSet.Len() > 0 {
-		path := cty.GetAttrPath("scopes")
-		if diags := validateTeammateScopes(scopesSet, path); diags.HasError() {
-			return diags
+		if resp.Diagnostics.HasError() {
+			return
 		}
-	}
 
-	var scopes []string
-	if !isAdmin {
-		scopesList := scopesSet.List()
-		for _, scope := range scopesList {
-			scopes = append(scopes, scope.(string))
-		}
-		// Sanitize scopes to remove any automatic ones
-		scopes = sanitizeScopes(scopes)
-	}
+		isAdmin := data.IsAdmin.ValueBool()
+		isSSO := data.IsSSO.ValueBool()
+		firstName := data.FirstName.ValueString()
+		lastName := data.LastName.ValueString()
 
-	_, err := enhancedRetryOnScopeErrors(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		if isSSO {
-			return client.UpdateSSOUser(ctx, firstName, lastName, email, scopes, isAdmin)
-		} else {
-			return client.UpdateUser(ctx, email, scopes, isAdmin)
-		}
-	})
+		_, err := enhancedRetryOnScopeErrors(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+			if isSSO {
+				return r.client.UpdateSSOUser(ctx, firstName, lastName, email, scopes, isAdmin)
+			}
 
-	if err != nil {
-		return diag.FromErr(err)
+			return r.client.UpdateUser(ctx, email, scopes, isAdmin)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(err.Error(), "")
+
+			return
+		}
 	}
 
-	return resourceSendgridTeammateRead(ctx, d, meta)
+	resp.Diagnostics.Append(configuredArgumentsOf(data.optionalArguments()).save(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func resourceSendgridTeammateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*sendgrid.Client)
+func (r *teammateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data teammateResourceModel
 
-	var diags diag.Diagnostics
-	userEmail := d.Id()
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	userEmail := data.ID.ValueString()
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return client.DeleteUser(ctx, userEmail)
+	_, err := sendgrid.RetryOnRateLimit(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+		return r.client.DeleteUser(ctx, userEmail)
 	})
 	if err != nil {
 		// Enhanced error handling for delete operations
-		if strings.Contains(err.Error(), "context canceled") || strings.Contains(err.Error(), "operation was canceled") {
-			return append(diags, diag.Errorf("Delete operation was canceled. The teammate may still exist in SendGrid. Please check your SendGrid dashboard and re-run the delete operation if needed.")...)
+		if errors.Is(err, context.Canceled) {
+			resp.Diagnostics.AddError("Delete operation was canceled. The teammate may still exist in SendGrid. Please check your SendGrid dashboard and re-run the delete operation if needed.", "")
+
+			return
 		}
-		return append(diags, diag.FromErr(err)...)
+
+		resp.Diagnostics.AddError(err.Error(), "")
 	}
-	return diags
+}
+
+func (r *teammateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// The whole teammate is read from SendGrid.
+	resp.Diagnostics.Append(allConfigured((&teammateResourceModel{}).optionalArguments()).save(ctx, resp.Private)...)
 }
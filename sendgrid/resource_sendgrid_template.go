@@ -36,6 +36,13 @@ func resourceSendgridTemplate() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -65,7 +72,7 @@ func resourceSendgridTemplateCreate(ctx context.Context, d *schema.ResourceData,
 	name := d.Get("name").(string)
 	generation := d.Get("generation").(string)
 
-	templateStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	templateStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, sendgrid.RequestError) {
 		return c.CreateTemplate(ctx, name, generation)
 	})
 	if err != nil {
@@ -83,11 +90,11 @@ func resourceSendgridTemplateCreate(ctx context.Context, d *schema.ResourceData,
 func resourceSendgridTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
-	templateStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	templateStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutRead), func() (interface{}, sendgrid.RequestError) {
 		return c.ReadTemplate(ctx, d.Id())
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return readDiagnostics(ctx, d, "sendgrid_template", err)
 	}
 
 	template := templateStruct.(*sendgrid.Template)
@@ -118,7 +125,7 @@ func resourceSendgridTemplateUpdate(ctx context.Context, d *schema.ResourceData,
 	c := m.(*sendgrid.Client)
 
 	if d.HasChange("name") {
-		_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+		_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
 			return c.UpdateTemplate(ctx, d.Id(), d.Get("name").(string))
 		})
 		if err != nil {
@@ -132,7 +139,7 @@ func resourceSendgridTemplateUpdate(ctx context.Context, d *schema.ResourceData,
 func resourceSendgridTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, sendgrid.RequestError) {
 		return c.DeleteTemplate(ctx, d.Id())
 	})
 	if err != nil {
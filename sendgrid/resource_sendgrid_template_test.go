@@ -16,9 +16,9 @@ func TestAccSendgridTemplateBasic(t *testing.T) {
 	generation := "dynamic"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckSendgridTemplateDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridTemplateDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckSendgridTemplateConfigBasic(name, generation),
@@ -32,273 +32,394 @@ import (
 	"strings"
 
 	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // ImportSplitParts is the expected length of
 // the splitted import string for template versions.
 const ImportSplitParts = 2
 
-func resourceSendgridTemplateVersion() *schema.Resource { //nolint:funlen
-	return &schema.Resource{
-		CreateContext: resourceSendgridTemplateVersionCreate,
-		ReadContext:   resourceSendgridTemplateVersionRead,
-		UpdateContext: resourceSendgridTemplateVersionUpdate,
-		DeleteContext: resourceSendgridTemplateVersionDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: resourceSendgridTemplateVersionImport,
-		},
+var (
+	_ resource.ResourceWithConfigure   = &templateVersionResource{}
+	_ resource.ResourceWithImportState = &templateVersionResource{}
+)
+
+// templateVersionResource manages a template version with the plugin framework.
+type templateVersionResource struct {
+	client *sendgrid.Client
+}
+
+func newTemplateVersionResource() resource.Resource {
+	return &templateVersionResource{}
+}
+
+type templateVersionResourceModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	TemplateID           types.String   `tfsdk:"template_id"`
+	UpdatedAt            types.String   `tfsdk:"updated_at"`
+	ThumbnailURL         types.String   `tfsdk:"thumbnail_url"`
+	Active               types.Int64    `tfsdk:"active"`
+	Name                 types.String   `tfsdk:"name"`
+	HTMLContent          types.String   `tfsdk:"html_content"`
+	PlainContent         types.String   `tfsdk:"plain_content"`
+	GeneratePlainContent types.Bool     `tfsdk:"generate_plain_content"`
+	Subject              types.String   `tfsdk:"subject"`
+	Editor               types.String   `tfsdk:"editor"`
+	TestData             types.String   `tfsdk:"test_data"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+// optionalArguments returns the optional arguments, see configuredArguments.
+func (m *templateVersionResourceModel) optionalArguments() map[string]attr.Value {
+	return map[string]attr.Value{
+		"active":       m.Active,
+		"html_content": m.HTMLContent,
+		"test_data":    m.TestData,
+	}
+}
+
+func (m *templateVersionResourceModel) templateVersion() sendgrid.TemplateVersion {
+	return sendgrid.TemplateVersion{
+		ID:                   m.ID.ValueString(),
+		TemplateID:           m.TemplateID.ValueString(),
+		Active:               int(m.Active.ValueInt64()),
+		Name:                 m.Name.ValueString(),
+		HTMLContent:          m.HTMLContent.ValueString(),
+		PlainContent:         m.PlainContent.ValueString(),
+		GeneratePlainContent: m.GeneratePlainContent.ValueBool(),
+		Subject:              m.Subject.ValueString(),
+		Editor:               m.Editor.ValueString(),
+		TestData:             m.TestData.ValueString(),
+	}
+}
+
+// setComputed sets the attributes computed by SendGrid.
+func (m *templateVersionResourceModel) setComputed(templateVersion *sendgrid.TemplateVersion) {
+	m.UpdatedAt = types.StringValue(templateVersion.UpdatedAt)
+	m.ThumbnailURL = types.StringValue(templateVersion.ThumbnailURL)
+
+	if m.PlainContent.IsUnknown() {
+		m.PlainContent = types.StringValue(templateVersion.PlainContent)
+	}
+}
+
+func (r *templateVersionResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_template_version"
+}
 
-		Schema: map[string]*schema.Schema{
-			"template_id": {
-				Type:        schema.TypeString,
+func (r *templateVersionResource) Schema( //nolint:funlen
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"template_id": schema.StringAttribute{
 				Description: "ID of the transactional template.",
 				Required:    true,
 			},
-			"updated_at": {
-				Type:        schema.TypeString,
+			"updated_at": schema.StringAttribute{
 				Description: "The date and time that this transactional template version was updated.",
 				Computed:    true,
 			},
-			"thumbnail_url": {
-				Type:        schema.TypeString,
+			"thumbnail_url": schema.StringAttribute{
 				Description: "A thumbnail preview of the template's html content.",
 				Computed:    true,
 			},
-			"active": {
-				Type: schema.TypeInt,
+			"active": schema.Int64Attribute{
 				Description: "Set the version as the active version associated with the template. " +
 					"Only one version of a template can be active. " +
 					"The first version created for a template will automatically be set to Active. Allowed values: 0, 1.",
 				Optional: true,
 			},
-			"name": {
-				Type:        schema.TypeString,
+			"name": schema.StringAttribute{
 				Description: "Name of the transactional template version, max length: 100.",
 				Required:    true,
 			},
-			"html_content": {
-				Type:        schema.TypeString,
+			"html_content": schema.StringAttribute{
 				Description: "The HTML content of the version, maximum of 1048576 bytes allowed.",
 				Optional:    true,
 			},
-			"plain_content": {
-				Type:        schema.TypeString,
+			"plain_content": schema.StringAttribute{
 				Description: "Text/plain content of the transactional template version, maximum of 1048576 bytes allowed.",
 				Computed:    true,
 				Optional:    true,
 			},
-			"generate_plain_content": {
-				Type: schema.TypeBool,
+			// The framework can only default an argument that is also computed.
+			"generate_plain_content": schema.BoolAttribute{
 				Description: "If true (default), plain_content is always generated from html_content. " +
 					"If false, plain_content is not altered.",
 				Optional: true,
-				Default:  true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
 			},
-			"subject": {
-				Type:        schema.TypeString,
+			"subject": schema.StringAttribute{
 				Description: "Subject of the new transactional template version, max length: 255.",
 				Required:    true,
 			},
-			"editor": {
-				Type:         schema.TypeString,
-				Description:  "The editor used in the UI, allowed values: code (default), design.",
-				Optional:     true,
-				Default:      "code",
-				ValidateFunc: validation.StringInSlice([]string{"code", "design"}, false),
+			"editor": schema.StringAttribute{
+				Description: "The editor used in the UI, allowed values: code (default), design.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("code"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("code", "design"),
+				},
 			},
-			"test_data": {
-				Type: schema.TypeString,
+			"test_data": schema.StringAttribute{
 				Description: "For dynamic templates only, " +
 					"the mock json data that will be used for template preview and test sends.",
 				Optional: true,
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
-func resourceSendgridTemplateVersionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
-
-	templateVersionStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.CreateTemplateVersion(ctx, sendgrid.TemplateVersion{
-			TemplateID:           d.Get("template_id").(string),
-			Active:               d.Get("active").(int),
-			Name:                 d.Get("name").(string),
-			HTMLContent:          d.Get("html_content").(string),
-			PlainContent:         d.Get("plain_content").(string),
-			GeneratePlainContent: d.Get("generate_plain_content").(bool),
-			Subject:              d.Get("subject").(string),
-			Editor:               d.Get("editor").(string),
-			TestData:             d.Get("test_data").(string),
-		})
-	})
-	if err != nil {
-		return diag.FromErr(err)
-	}
+func (r *templateVersionResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	r.client = providerClient(req.ProviderData, &resp.Diagnostics)
+}
 
-	templateVersion := templateVersionStruct.(*sendgrid.TemplateVersion)
-	//nolint:errcheck
-	d.Set("updated_at", templateVersion.UpdatedAt)
-	d.SetId(templateVersion.ID)
+func (r *templateVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data templateVersionResourceModel
 
-	return nil
-}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
 
-func resourceSendgridTemplateVersionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	templateVersionStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.ReadTemplateVersion(ctx, d.Get("template_id").(string), d.Id())
+	templateVersionStruct, err := sendgrid.RetryOnRateLimit(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+		return r.client.CreateTemplateVersion(ctx, data.templateVersion())
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError(err.Error(), "")
+
+		return
 	}
 
 	templateVersion := templateVersionStruct.(*sendgrid.TemplateVersion)
-	if er := parseTemplateVersion(d, templateVersion); er != nil {
-		return diag.FromErr(er)
-	}
+	data.ID = types.StringValue(templateVersion.ID)
+	data.setComputed(templateVersion)
 
-	return nil
+	resp.Diagnostics.Append(configuredArgumentsOf(data.optionalArguments()).save(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func parseTemplateVersion(d *schema.ResourceData, templateVersion *sendgrid.TemplateVersion) error {
-	if err := d.Set("updated_at", templateVersion.UpdatedAt); err != nil {
-		return ErrSetTemplateVersionUpdatedAt
-	}
+func (r *templateVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data templateVersionResourceModel
 
-	if err := d.Set("thumbnail_url", templateVersion.ThumbnailURL); err != nil {
-		return ErrSetTemplateVersionThumbnailURL
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if err := d.Set("active", templateVersion.Active); err != nil {
-		return ErrSetTemplateVersionActive
-	}
+	timeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
 
-	if err := d.Set("name", templateVersion.Name); err != nil {
-		return ErrSetTemplateVersionName
-	}
+	configured, diags := readConfiguredArguments(ctx, req.Private, data.optionalArguments())
+	resp.Diagnostics.Append(diags...)
 
-	if err := d.Set("html_content", templateVersion.HTMLContent); err != nil {
-		return ErrSetTemplateVersionHTMLContent
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if err := d.Set("plain_content", templateVersion.PlainContent); err != nil {
-		return ErrSetTemplateVersionPlainContent
-	}
+	templateVersionStruct, err := sendgrid.RetryOnRateLimit(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+		return r.client.ReadTemplateVersion(ctx, data.TemplateID.ValueString(), data.ID.ValueString())
+	})
+	if err != nil {
+		readNotFound(ctx, resp, "sendgrid_template_version", data.ID.ValueString(), err)
 
-	if err := d.Set("generate_plain_content", templateVersion.GeneratePlainContent); err != nil {
-		return ErrSetTemplateVersionGenPlainContent
+		return
 	}
 
-	if err := d.Set("subject", templateVersion.Subject); err != nil {
-		return ErrSetTemplateVersionSubject
+	templateVersion := templateVersionStruct.(*sendgrid.TemplateVersion)
+	data.UpdatedAt = types.StringValue(templateVersion.UpdatedAt)
+	data.ThumbnailURL = types.StringValue(templateVersion.ThumbnailURL)
+	data.Active = configured.int64("active", int64(templateVersion.Active))
+	data.Name = types.StringValue(templateVersion.Name)
+	data.HTMLContent = configured.string("html_content", templateVersion.HTMLContent)
+	data.PlainContent = types.StringValue(templateVersion.PlainContent)
+	data.GeneratePlainContent = types.BoolValue(templateVersion.GeneratePlainContent)
+	data.Subject = types.StringValue(templateVersion.Subject)
+	data.Editor = types.StringValue(templateVersion.Editor)
+
+	// The test data isn't read back, it's kept as configured.
+	if !configured["test_data"] {
+		data.TestData = types.StringNull()
 	}
 
-	if err := d.Set("editor", templateVersion.Editor); err != nil {
-		return ErrSetTemplateVersionEditor
+	resp.Diagnostics.Append(configured.save(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *templateVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state templateVersionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return nil
-}
+	timeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
 
-func resourceSendgridTemplateVersionUpdate(
-	ctx context.Context,
-	d *schema.ResourceData,
-	m interface{},
-) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	baseTemplateVersion := sendgrid.TemplateVersion{
-		ID:         d.Id(),
-		TemplateID: d.Get("template_id").(string),
+		ID:         state.ID.ValueString(),
+		TemplateID: data.TemplateID.ValueString(),
 	}
 	templateVersion := baseTemplateVersion
+	planned := data.templateVersion()
 
-	if d.HasChange("active") {
-		templateVersion.Active = d.Get("active").(int)
+	if !data.Active.Equal(state.Active) {
+		templateVersion.Active = planned.Active
 	}
 
 	if templateVersion.Active == 1 {
-		_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-			return c.ActivateTemplateVersion(ctx, templateVersion)
+		_, err := sendgrid.RetryOnRateLimit(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+			return r.client.ActivateTemplateVersion(ctx, templateVersion)
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			resp.Diagnostics.AddError(err.Error(), "")
+
+			return
 		}
 	}
 
-	if d.HasChange("name") {
-		templateVersion.Name = d.Get("name").(string)
+	if !data.Name.Equal(state.Name) {
+		templateVersion.Name = planned.Name
 	}
 
-	if d.HasChange("html_content") {
-		templateVersion.HTMLContent = d.Get("html_content").(string)
+	if !data.HTMLContent.Equal(state.HTMLContent) {
+		templateVersion.HTMLContent = planned.HTMLContent
 	}
 
-	if d.HasChange("plain_content") {
-		templateVersion.PlainContent = d.Get("plain_content").(string)
+	if !data.PlainContent.IsUnknown() && !data.PlainContent.Equal(state.PlainContent) {
+		templateVersion.PlainContent = planned.PlainContent
 	}
 
-	if d.HasChange("generate_plain_content") {
-		templateVersion.GeneratePlainContent = d.Get("generate_plain_content").(bool)
+	if !data.GeneratePlainContent.Equal(state.GeneratePlainContent) {
+		templateVersion.GeneratePlainContent = planned.GeneratePlainContent
 	}
 
-	if d.HasChange("subject") {
-		templateVersion.Subject = d.Get("subject").(string)
+	if !data.Subject.Equal(state.Subject) {
+		templateVersion.Subject = planned.Subject
 	}
 
-	if d.HasChange("editor") {
-		templateVersion.Editor = d.Get("editor").(string)
+	if !data.Editor.Equal(state.Editor) {
+		templateVersion.Editor = planned.Editor
 	}
 
-	if d.HasChange("test_data") {
-		templateVersion.TestData = d.Get("test_data").(string)
+	if !data.TestData.Equal(state.TestData) {
+		templateVersion.TestData = planned.TestData
 	}
 
-	if reflect.DeepEqual(baseTemplateVersion, templateVersion) {
-		return nil
+	if !reflect.DeepEqual(baseTemplateVersion, templateVersion) {
+		_, err := sendgrid.RetryOnRateLimit(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+			return r.client.UpdateTemplateVersion(ctx, templateVersion)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(err.Error(), "")
+
+			return
+		}
 	}
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.UpdateTemplateVersion(ctx, templateVersion)
+	templateVersionStruct, err := sendgrid.RetryOnRateLimit(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+		return r.client.ReadTemplateVersion(ctx, baseTemplateVersion.TemplateID, baseTemplateVersion.ID)
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError(err.Error(), "")
+
+		return
 	}
 
-	return resourceSendgridTemplateVersionRead(ctx, d, m)
+	data.ID = state.ID
+	data.setComputed(templateVersionStruct.(*sendgrid.TemplateVersion))
+
+	resp.Diagnostics.Append(configuredArgumentsOf(data.optionalArguments()).save(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func resourceSendgridTemplateVersionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+func (r *templateVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data templateVersionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.DeleteTemplateVersion(ctx, d.Get("template_id").(string), d.Id())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+		return r.client.DeleteTemplateVersion(ctx, data.TemplateID.ValueString(), data.ID.ValueString())
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		resp.Diagnostics.AddError(err.Error(), "")
 	}
-
-	return nil
 }
 
-func resourceSendgridTemplateVersionImport(
+func (r *templateVersionResource) ImportState(
 	ctx context.Context,
-	d *schema.ResourceData,
-	_ interface{},
-) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), "/")
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	parts := strings.Split(req.ID, "/")
 	if len(parts) != ImportSplitParts {
-		return nil, ErrInvalidImportFormat
+		resp.Diagnostics.AddError(ErrInvalidImportFormat.Error(), "")
+
+		return
 	}
 
-	//nolint:errcheck
-	d.Set("template_id", parts[0])
-	d.SetId(parts[1])
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
 
-	return []*schema.ResourceData{d}, nil
+	// The whole template version is read from SendGrid.
+	resp.Diagnostics.Append(allConfigured((&templateVersionResourceModel{}).optionalArguments()).save(ctx, resp.Private)...)
 }
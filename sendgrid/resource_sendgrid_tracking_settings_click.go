@@ -0,0 +1,95 @@
+/*
+Provide a resource to manage the tracking of the clicks on the links of the emails.
+Example Usage
+```hcl
+
+	resource "sendgrid_tracking_settings_click" "default" {
+		enabled     = true
+		enable_text = false
+	}
+
+```
+Destroying the resource disables the click tracking.
+Import
+The click tracking setting can be imported with "default", or the subuser name when the provider sets a subuser, e.g.
+```hcl
+$ terraform import sendgrid_tracking_settings_click.default default
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSendgridTrackingSettingsClick() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridTrackingSettingsClickUpdate,
+		ReadContext:   resourceSendgridTrackingSettingsClickRead,
+		UpdateContext: resourceSendgridTrackingSettingsClickUpdate,
+		DeleteContext: trackingSettingDelete(sendgrid.TrackingSettingClick),
+		Importer: &schema.ResourceImporter{
+			StateContext: importSingleton,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the links of the emails are rewritten to track the clicks.",
+				Required:    true,
+			},
+			"enable_text": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the links of the plain text part of the emails are tracked too.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceSendgridTrackingSettingsClickUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting := sendgrid.TrackingSettingsClick{
+		Enabled:    d.Get("enabled").(bool),
+		EnableText: d.Get("enable_text").(bool),
+	}
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, singletonTimeout(d), func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateTrackingSettingsClick(ctx, setting)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(singletonID(c))
+
+	return resourceSendgridTrackingSettingsClickRead(ctx, d, m)
+}
+
+func resourceSendgridTrackingSettingsClickRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting, err := c.ReadTrackingSettingsClick(ctx)
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_tracking_settings_click", err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("enable_text", setting.EnableText)
+
+	return nil
+}
@@ -0,0 +1,137 @@
+/*
+Provide a resource to manage the Google Analytics UTM parameters added to the links of the emails.
+Example Usage
+```hcl
+
+	resource "sendgrid_tracking_settings_google_analytics" "default" {
+		enabled      = true
+		utm_source   = "sendgrid"
+		utm_medium   = "email"
+		utm_campaign = "newsletter"
+	}
+
+```
+Destroying the resource disables the Google Analytics tracking, and leaves its parameters as is.
+Import
+The Google Analytics tracking setting can be imported with "default", or the subuser name when the provider sets a subuser, e.g.
+```hcl
+$ terraform import sendgrid_tracking_settings_google_analytics.default default
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSendgridTrackingSettingsGoogleAnalytics() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridTrackingSettingsGoogleAnalyticsUpdate,
+		ReadContext:   resourceSendgridTrackingSettingsGoogleAnalyticsRead,
+		UpdateContext: resourceSendgridTrackingSettingsGoogleAnalyticsUpdate,
+		DeleteContext: trackingSettingDelete(sendgrid.TrackingSettingGoogleAnalytics),
+		Importer: &schema.ResourceImporter{
+			StateContext: importSingleton,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if the UTM parameters are added to the links of the emails.",
+				Required:    true,
+			},
+			"utm_source": {
+				Type:        schema.TypeString,
+				Description: "The utm_source parameter, naming the referrer, e.g. sendgrid.",
+				Optional:    true,
+			},
+			"utm_medium": {
+				Type:        schema.TypeString,
+				Description: "The utm_medium parameter, naming the marketing medium, e.g. email.",
+				Optional:    true,
+			},
+			"utm_term": {
+				Type:        schema.TypeString,
+				Description: "The utm_term parameter, naming the paid keywords.",
+				Optional:    true,
+			},
+			"utm_content": {
+				Type:        schema.TypeString,
+				Description: "The utm_content parameter, telling apart the links pointing to the same URL.",
+				Optional:    true,
+			},
+			"utm_campaign": {
+				Type:        schema.TypeString,
+				Description: "The utm_campaign parameter, naming the campaign.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceSendgridTrackingSettingsGoogleAnalyticsUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting := sendgrid.TrackingSettingsGoogleAnalytics{
+		Enabled:     d.Get("enabled").(bool),
+		UTMSource:   d.Get("utm_source").(string),
+		UTMMedium:   d.Get("utm_medium").(string),
+		UTMTerm:     d.Get("utm_term").(string),
+		UTMContent:  d.Get("utm_content").(string),
+		UTMCampaign: d.Get("utm_campaign").(string),
+	}
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, singletonTimeout(d), func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateTrackingSettingsGoogleAnalytics(ctx, setting)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(singletonID(c))
+
+	return resourceSendgridTrackingSettingsGoogleAnalyticsRead(ctx, d, m)
+}
+
+func resourceSendgridTrackingSettingsGoogleAnalyticsRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting, err := c.ReadTrackingSettingsGoogleAnalytics(ctx)
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_tracking_settings_google_analytics", err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("utm_source", setting.UTMSource)
+	//nolint:errcheck
+	d.Set("utm_medium", setting.UTMMedium)
+	//nolint:errcheck
+	d.Set("utm_term", setting.UTMTerm)
+	//nolint:errcheck
+	d.Set("utm_content", setting.UTMContent)
+	//nolint:errcheck
+	d.Set("utm_campaign", setting.UTMCampaign)
+
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Provide a resource to manage the tracking of the opens of the emails.
+Example Usage
+```hcl
+
+	resource "sendgrid_tracking_settings_open" "default" {
+		enabled = false
+	}
+
+```
+Destroying the resource disables the open tracking.
+Import
+The open tracking setting can be imported with "default", or the subuser name when the provider sets a subuser, e.g.
+```hcl
+$ terraform import sendgrid_tracking_settings_open.default default
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSendgridTrackingSettingsOpen() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridTrackingSettingsOpenUpdate,
+		ReadContext:   resourceSendgridTrackingSettingsOpenRead,
+		UpdateContext: resourceSendgridTrackingSettingsOpenUpdate,
+		DeleteContext: trackingSettingDelete(sendgrid.TrackingSettingOpen),
+		Importer: &schema.ResourceImporter{
+			StateContext: importSingleton,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if an invisible image is added to the emails to track the opens.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func resourceSendgridTrackingSettingsOpenUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting := sendgrid.TrackingSettingsOpen{
+		Enabled: d.Get("enabled").(bool),
+	}
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, singletonTimeout(d), func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateTrackingSettingsOpen(ctx, setting)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(singletonID(c))
+
+	return resourceSendgridTrackingSettingsOpenRead(ctx, d, m)
+}
+
+func resourceSendgridTrackingSettingsOpenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting, err := c.ReadTrackingSettingsOpen(ctx)
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_tracking_settings_open", err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+
+	return nil
+}
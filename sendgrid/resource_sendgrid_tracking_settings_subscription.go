@@ -0,0 +1,149 @@
+/*
+Provide a resource to manage the unsubscribe link added to the emails, and the page
+the recipients land on once unsubscribed.
+Example Usage
+```hcl
+
+	resource "sendgrid_tracking_settings_subscription" "default" {
+		enabled       = true
+		html_content  = "<p>Don't want these emails? <% Unsubscribe %>.</p>"
+		plain_content = "Don't want these emails? Unsubscribe: <% %>"
+		landing       = "<html><body><p>You are unsubscribed.</p></body></html>"
+	}
+
+```
+Destroying the resource disables the subscription tracking, and leaves its content as is.
+Import
+The subscription tracking setting can be imported with "default", or the subuser name when the provider sets a subuser, e.g.
+```hcl
+$ terraform import sendgrid_tracking_settings_subscription.default default
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"regexp"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// unsubscribeTag is the tag replaced by the unsubscribe link in the subscription
+// tracking contents. The text between <% and %> becomes the text of the link.
+var unsubscribeTag = regexp.MustCompile(`<%.*?%>`)
+
+func resourceSendgridTrackingSettingsSubscription() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridTrackingSettingsSubscriptionUpdate,
+		ReadContext:   resourceSendgridTrackingSettingsSubscriptionRead,
+		UpdateContext: resourceSendgridTrackingSettingsSubscriptionUpdate,
+		DeleteContext: trackingSettingDelete(sendgrid.TrackingSettingSubscription),
+		Importer: &schema.ResourceImporter{
+			StateContext: importSingleton,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Indicates if an unsubscribe link is added to the emails.",
+				Required:    true,
+			},
+			"html_content": {
+				Type: schema.TypeString,
+				Description: "The HTML added to the emails. " +
+					"The <% %> tag is replaced by the unsubscribe link, with the text between <% and %> as its text.",
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(unsubscribeTag, "must contain the <% %> tag"),
+			},
+			"plain_content": {
+				Type: schema.TypeString,
+				Description: "The plain text added to the emails. " +
+					"The <% %> tag is replaced by the unsubscribe link.",
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(unsubscribeTag, "must contain the <% %> tag"),
+			},
+			"landing": {
+				Type:        schema.TypeString,
+				Description: "The HTML of the page the recipients land on once unsubscribed.",
+				Optional:    true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The URL of a page of your own the recipients land on, rather than the landing HTML.",
+				Optional:    true,
+			},
+			"replace": {
+				Type: schema.TypeString,
+				Description: "A tag of the emails replaced by the unsubscribe link. " +
+					"The link is added at the bottom of the emails without the tag.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceSendgridTrackingSettingsSubscriptionUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting := sendgrid.TrackingSettingsSubscription{
+		Enabled:      d.Get("enabled").(bool),
+		HTMLContent:  d.Get("html_content").(string),
+		PlainContent: d.Get("plain_content").(string),
+		Landing:      d.Get("landing").(string),
+		URL:          d.Get("url").(string),
+		Replace:      d.Get("replace").(string),
+	}
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, singletonTimeout(d), func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateTrackingSettingsSubscription(ctx, setting)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(singletonID(c))
+
+	return resourceSendgridTrackingSettingsSubscriptionRead(ctx, d, m)
+}
+
+func resourceSendgridTrackingSettingsSubscriptionRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	setting, err := c.ReadTrackingSettingsSubscription(ctx)
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_tracking_settings_subscription", err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("html_content", setting.HTMLContent)
+	//nolint:errcheck
+	d.Set("plain_content", setting.PlainContent)
+	//nolint:errcheck
+	d.Set("landing", setting.Landing)
+	//nolint:errcheck
+	d.Set("url", setting.URL)
+	//nolint:errcheck
+	d.Set("replace", setting.Replace)
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitSendgridTrackingSettings(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	config := testUnitProviderConfig(server) + `
+resource "sendgrid_tracking_settings_click" "test" {
+  enabled = false
+}
+
+resource "sendgrid_tracking_settings_open" "test" {
+  enabled = true
+}
+
+resource "sendgrid_tracking_settings_subscription" "test" {
+  enabled       = true
+  html_content  = "<p>Don't want these emails? <% Unsubscribe %>.</p>"
+  plain_content = "Unsubscribe: <% %>"
+  landing       = "<p>You are unsubscribed.</p>"
+}
+
+resource "sendgrid_tracking_settings_google_analytics" "test" {
+  enabled      = true
+  utm_source   = "sendgrid"
+  utm_medium   = "email"
+  utm_campaign = "newsletter"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testUnitCheckTrackingSettingsDisabled,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.test", "id", "default"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.test", "enable_text", "false"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_subscription.test", "landing", "<p>You are unsubscribed.</p>"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_subscription.test", "url", ""),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_google_analytics.test", "utm_term", ""),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_google_analytics.test", "utm_campaign", "newsletter"),
+				),
+			},
+			{
+				// A change made outside of Terraform is reverted.
+				PreConfig: func() {
+					_, err := server.Client().UpdateTrackingSettingsClick(context.Background(), sendgrid.TrackingSettingsClick{
+						Enabled: true, EnableText: true,
+					})
+					if err.Err != nil {
+						t.Fatalf("failed changing the click tracking: %v", err.Err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.test", "enable_text", "false"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_tracking_settings_click.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sendgrid_tracking_settings_open.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sendgrid_tracking_settings_subscription.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "sendgrid_tracking_settings_google_analytics.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitSendgridTrackingSettingsSubuser(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "sendgrid" {
+  api_key = "%s"
+  host    = "%s"
+  subuser = "compliance"
+}
+
+resource "sendgrid_tracking_settings_click" "test" {
+  enabled = false
+}
+`, sendgridtest.APIKey, server.URL),
+				Check: resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.test", "id", "compliance"),
+			},
+			{
+				ResourceName:      "sendgrid_tracking_settings_click.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "sendgrid_tracking_settings_click.test",
+				ImportState:   true,
+				ImportStateId: "default",
+				ExpectError:   regexp.MustCompile(`got "default", want "compliance"`),
+			},
+		},
+	})
+}
+
+func TestUnitSendgridTrackingSettingsSubscription_missingTag(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + `
+resource "sendgrid_tracking_settings_subscription" "test" {
+  enabled      = true
+  html_content = "<p>Unsubscribe</p>"
+}
+`,
+				ExpectError: regexp.MustCompile(`must contain the <% %> tag`),
+			},
+		},
+	})
+}
+
+// testUnitCheckTrackingSettingsDisabled checks that the destroyed tracking settings are disabled.
+func testUnitCheckTrackingSettingsDisabled(*terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+	ctx := context.Background()
+
+	open, err := c.ReadTrackingSettingsOpen(ctx)
+	if err.Err != nil {
+		return err.Err
+	}
+
+	subscription, err := c.ReadTrackingSettingsSubscription(ctx)
+	if err.Err != nil {
+		return err.Err
+	}
+
+	if open.Enabled || subscription.Enabled {
+		return fmt.Errorf("tracking settings still enabled: open %t, subscription %t", open.Enabled, subscription.Enabled)
+	}
+
+	if subscription.Landing != "<p>You are unsubscribed.</p>" {
+		return fmt.Errorf("subscription landing = %q, want it left as is", subscription.Landing)
+	}
+
+	return nil
+}
@@ -38,6 +38,13 @@ func resourceSendgridUnsubscribeGroup() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -76,7 +83,7 @@ func resourceSendgridUnsubscribeGroupCreate(
 	description := d.Get("description").(string)
 	isDefault := d.Get("is_default").(bool)
 
-	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, sendgrid.RequestError) {
 		return c.CreateUnsubscribeGroup(ctx, name, description, isDefault)
 	})
 
@@ -96,7 +103,7 @@ func resourceSendgridUnsubscribeGroupRead(ctx context.Context, d *schema.Resourc
 
 	group, err := c.ReadUnsubscribeGroup(ctx, d.Id())
 	if err.Err != nil {
-		return diag.FromErr(err.Err)
+		return readDiagnostics(ctx, d, "sendgrid_unsubscribe_group", err.Err)
 	}
 
 	if err := sendgridUnsubscribeGroupParse(group, d); err != nil {
@@ -137,7 +144,7 @@ func resourceSendgridUnsubscribeGroupUpdate(
 	description := d.Get("description").(string)
 	isDefault := d.Get("is_default").(bool)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
 		return c.UpdateUnsubscribeGroup(ctx, d.Id(), name, description, isDefault)
 	})
 	if err != nil {
@@ -154,7 +161,7 @@ func resourceSendgridUnsubscribeGroupDelete(
 ) diag.Diagnostics {
 	c := m.(*sendgrid.Client)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, sendgrid.RequestError) {
 		return c.DeleteUnsubscribeGroup(ctx, d.Id())
 	})
 	if err != nil {
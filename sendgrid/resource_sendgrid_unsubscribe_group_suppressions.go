@@ -0,0 +1,287 @@
+/*
+Provide a resource to manage the emails suppressed from an unsubscribe group.
+Example Usage
+```hcl
+
+	resource "sendgrid_unsubscribe_group" "newsletter" {
+		name        = "newsletter"
+		description = "The weekly newsletter"
+	}
+
+	resource "sendgrid_unsubscribe_group_suppressions" "newsletter" {
+		group_id = sendgrid_unsubscribe_group.newsletter.id
+		emails   = ["qa-seed@example.com", "legal-hold@example.com"]
+	}
+
+```
+By default the resource only manages the listed emails, and leaves the recipients who
+unsubscribed themselves alone. Set authoritative to true to remove every other email
+from the group. Setting it back to false removes nothing from the group.
+Import
+The suppressions of an unsubscribe group can be imported with the group ID, e.g.
+```hcl
+$ terraform import sendgrid_unsubscribe_group_suppressions.newsletter unsubscribeGroupID
+```
+An imported resource is authoritative: it holds every email suppressed from the group.
+*/
+package sendgrid
+
+import (
+	"context"
+	"strings"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSendgridUnsubscribeGroupSuppressions() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridUnsubscribeGroupSuppressionsCreate,
+		ReadContext:   resourceSendgridUnsubscribeGroupSuppressionsRead,
+		UpdateContext: resourceSendgridUnsubscribeGroupSuppressionsUpdate,
+		DeleteContext: resourceSendgridUnsubscribeGroupSuppressionsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridUnsubscribeGroupSuppressionsImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the unsubscribe group.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"emails": {
+				Type:        schema.TypeSet,
+				Description: "The emails suppressed from the unsubscribe group.",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, maxEmailLength),
+				},
+			},
+			"authoritative": {
+				Type: schema.TypeBool,
+				Description: "Whether the emails are the only ones suppressed from the group. " +
+					"When true, the emails suppressed outside of Terraform, e.g. by recipients clicking " +
+					"the unsubscribe link, are removed from the group.",
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+// emailList returns the emails of a set.
+func emailList(v interface{}) []string {
+	var emails []string
+
+	for _, email := range v.(*schema.Set).List() {
+		emails = append(emails, email.(string))
+	}
+
+	return emails
+}
+
+// missingEmails returns the emails of want that aren't in have. SendGrid matches emails
+// case-insensitively, so the comparison does too.
+func missingEmails(want, have []string) []string {
+	present := map[string]bool{}
+	for _, email := range have {
+		present[strings.ToLower(email)] = true
+	}
+
+	var missing []string
+
+	for _, email := range want {
+		if !present[strings.ToLower(email)] {
+			missing = append(missing, email)
+		}
+	}
+
+	return missing
+}
+
+// configuredCase returns the emails found in SendGrid, spelled as in the configuration
+// when they match a configured email, so a change of case isn't a diff.
+func configuredCase(found, configured []string) []string {
+	spelling := map[string]string{}
+	for _, email := range configured {
+		spelling[strings.ToLower(email)] = email
+	}
+
+	emails := make([]string, 0, len(found))
+
+	for _, email := range found {
+		if configuredEmail, ok := spelling[strings.ToLower(email)]; ok {
+			email = configuredEmail
+		}
+
+		emails = append(emails, email)
+	}
+
+	return emails
+}
+
+// syncGroupSuppressions adds the emails missing from the group, in batches, and removes the
+// current ones that aren't wanted anymore.
+func syncGroupSuppressions(
+	ctx context.Context,
+	d *schema.ResourceData,
+	c *sendgrid.Client,
+	timeout string,
+	current, want []string,
+) diag.Diagnostics {
+	groupID := d.Get("group_id").(string)
+
+	if add := missingEmails(want, current); len(add) > 0 {
+		_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(timeout), func() (interface{}, sendgrid.RequestError) {
+			return c.AddGroupSuppressions(ctx, groupID, add)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for _, email := range missingEmails(current, want) {
+		_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(timeout), func() (interface{}, sendgrid.RequestError) {
+			return c.DeleteGroupSuppression(ctx, groupID, email)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceSendgridUnsubscribeGroupSuppressionsCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	groupID := d.Get("group_id").(string)
+
+	var current []string
+
+	if d.Get("authoritative").(bool) {
+		emails, err := c.ReadGroupSuppressions(ctx, groupID)
+		if err.Err != nil {
+			return diag.FromErr(err.Err)
+		}
+
+		current = emails
+	}
+
+	if diags := syncGroupSuppressions(ctx, d, c, schema.TimeoutCreate, current, emailList(d.Get("emails"))); diags != nil {
+		return diags
+	}
+
+	d.SetId(groupID)
+
+	return resourceSendgridUnsubscribeGroupSuppressionsRead(ctx, d, m)
+}
+
+func resourceSendgridUnsubscribeGroupSuppressionsRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	configured := emailList(d.Get("emails"))
+
+	var found []string
+
+	var err sendgrid.RequestError
+
+	switch {
+	case d.Get("authoritative").(bool):
+		found, err = c.ReadGroupSuppressions(ctx, d.Id())
+	case len(configured) == 0:
+		// Nothing to search, but a deleted group must still be noticed.
+		_, err = c.ReadUnsubscribeGroup(ctx, d.Id())
+	default:
+		found, err = c.SearchGroupSuppressions(ctx, d.Id(), configured)
+	}
+
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_unsubscribe_group_suppressions", err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("group_id", d.Id())
+	//nolint:errcheck
+	d.Set("emails", configuredCase(found, configured))
+
+	return nil
+}
+
+func resourceSendgridUnsubscribeGroupSuppressionsUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	// The state holds the managed emails found by the last refresh. An authoritative
+	// resource manages the whole group, including the emails of a former additive one.
+	oldEmails, newEmails := d.GetChange("emails")
+	current := emailList(oldEmails)
+
+	switch {
+	case !d.HasChange("authoritative"):
+	case d.Get("authoritative").(bool):
+		emails, err := c.ReadGroupSuppressions(ctx, d.Id())
+		if err.Err != nil {
+			return diag.FromErr(err.Err)
+		}
+
+		current = emails
+	default:
+		// The state of a formerly authoritative resource holds every email of the group,
+		// including the recipients who unsubscribed themselves, which mustn't be
+		// re-subscribed: the missing emails are only added.
+		current = nil
+	}
+
+	if diags := syncGroupSuppressions(ctx, d, c, schema.TimeoutUpdate, current, emailList(newEmails)); diags != nil {
+		return diags
+	}
+
+	return resourceSendgridUnsubscribeGroupSuppressionsRead(ctx, d, m)
+}
+
+func resourceSendgridUnsubscribeGroupSuppressionsDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	return syncGroupSuppressions(ctx, d, c, schema.TimeoutDelete, emailList(d.Get("emails")), nil)
+}
+
+// resourceSendgridUnsubscribeGroupSuppressionsImport imports the suppressions of a group
+// as an authoritative resource, which reads every email of the group.
+func resourceSendgridUnsubscribeGroupSuppressionsImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	//nolint:errcheck
+	d.Set("authoritative", true)
+
+	return []*schema.ResourceData{d}, nil
+}
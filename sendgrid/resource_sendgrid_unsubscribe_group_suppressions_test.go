@@ -0,0 +1,178 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitSendgridUnsubscribeGroupSuppressions(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	groupID := mustCreateUnsubscribeGroup(t, server.Client())
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testUnitCheckGroupSuppressions(groupID),
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) +
+					testUnitSendgridUnsubscribeGroupSuppressionsConfig(groupID, false, `["qa@example.com", "Legal@example.com"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_unsubscribe_group_suppressions.test", "id", groupID),
+					resource.TestCheckResourceAttr("sendgrid_unsubscribe_group_suppressions.test", "emails.#", "2"),
+					testUnitCheckGroupSuppressions(groupID, "legal@example.com", "qa@example.com"),
+				),
+			},
+			{
+				// A recipient unsubscribing themselves is left alone.
+				PreConfig: func() {
+					if err := server.Unsubscribe(groupID, "someone@example.com"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testUnitProviderConfig(server) +
+					testUnitSendgridUnsubscribeGroupSuppressionsConfig(groupID, false, `["qa@example.com", "new@example.com"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_unsubscribe_group_suppressions.test", "emails.#", "2"),
+					testUnitCheckGroupSuppressions(groupID, "new@example.com", "qa@example.com", "someone@example.com"),
+				),
+			},
+			{
+				Config: testUnitProviderConfig(server) +
+					testUnitSendgridUnsubscribeGroupSuppressionsConfig(groupID, true, `["qa@example.com", "new@example.com"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_unsubscribe_group_suppressions.test", "authoritative", "true"),
+					testUnitCheckGroupSuppressions(groupID, "new@example.com", "qa@example.com"),
+				),
+			},
+			{
+				// Addresses can be up to 254 characters long.
+				Config: testUnitProviderConfig(server) +
+					testUnitSendgridUnsubscribeGroupSuppressionsConfig(groupID, true, fmt.Sprintf(`["qa@example.com", %q]`, testUnitLongEmail)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("sendgrid_unsubscribe_group_suppressions.test", "emails.*", testUnitLongEmail),
+					testUnitCheckGroupSuppressions(groupID, testUnitLongEmail, "qa@example.com"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_unsubscribe_group_suppressions.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitSendgridUnsubscribeGroupSuppressionsBatches(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	groupID := mustCreateUnsubscribeGroup(t, server.Client())
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testUnitCheckGroupSuppressions(groupID),
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) +
+					testUnitSendgridUnsubscribeGroupSuppressionsConfig(groupID, false, testUnitManyEmails(500)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_unsubscribe_group_suppressions.test", "emails.#", "1500"),
+					testUnitCheckSuppressionRequests(server, "POST", "/asm/groups/"+groupID+"/suppressions", 2),
+				),
+			},
+			{
+				// Only the new email is sent.
+				Config: testUnitProviderConfig(server) +
+					testUnitSendgridUnsubscribeGroupSuppressionsConfig(groupID, false, testUnitManyEmails(501)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_unsubscribe_group_suppressions.test", "emails.#", "1501"),
+					testUnitCheckSuppressionRequests(server, "POST", "/asm/groups/"+groupID+"/suppressions", 3),
+					testUnitCheckSuppressionRequests(server, "DELETE", "/asm/groups/"+groupID+"/suppressions/", 0),
+				),
+			},
+		},
+	})
+}
+
+func mustCreateUnsubscribeGroup(t *testing.T, client *sendgrid.Client) string {
+	t.Helper()
+
+	group, err := client.CreateUnsubscribeGroup(context.Background(), "suppressions", "Suppressions test", false)
+	if err.Err != nil {
+		t.Fatalf("failed creating the unsubscribe group: %v", err.Err)
+	}
+
+	return fmt.Sprint(group.ID)
+}
+
+// testUnitManyEmails returns a list of 1000 + n emails. Terraform's range can't
+// generate more than 1024 values at once.
+func testUnitManyEmails(n int) string {
+	return fmt.Sprintf(`concat([for i in range(1000) : "user${i}@example.com"], [for i in range(%d) : "other${i}@example.com"])`, n)
+}
+
+func testUnitSendgridUnsubscribeGroupSuppressionsConfig(groupID string, authoritative bool, emails string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_unsubscribe_group_suppressions" "test" {
+  group_id      = %q
+  authoritative = %t
+  emails        = %s
+}
+`, groupID, authoritative, emails)
+}
+
+// testUnitCheckGroupSuppressions checks the lowercased emails suppressed from the group.
+func testUnitCheckGroupSuppressions(groupID string, want ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		emails, err := c.ReadGroupSuppressions(context.Background(), groupID)
+		if err.Err != nil {
+			return err.Err
+		}
+
+		got := make([]string, 0, len(emails))
+		for _, email := range emails {
+			got = append(got, strings.ToLower(email))
+		}
+
+		slices.Sort(got)
+
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("group %s suppressions = %v, want %v", groupID, got, want)
+		}
+
+		return nil
+	}
+}
+
+// testUnitCheckSuppressionRequests counts the requests whose path starts with the prefix.
+func testUnitCheckSuppressionRequests(server *sendgridtest.Server, method, prefix string, want int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		count := 0
+
+		for _, r := range server.Requests() {
+			if r.Method == method && strings.HasPrefix(r.Path, prefix) && !strings.HasSuffix(r.Path, "/search") {
+				count++
+			}
+		}
+
+		if count != want {
+			return fmt.Errorf("%d %s %s requests, want %d", count, method, prefix, want)
+		}
+
+		return nil
+	}
+}
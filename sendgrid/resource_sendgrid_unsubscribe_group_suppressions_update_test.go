@@ -0,0 +1,92 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceSendgridUnsubscribeGroupSuppressions_leaveAuthoritative(t *testing.T) {
+	t.Parallel()
+
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	c := server.Client()
+	ctx := context.Background()
+
+	group, err := c.CreateUnsubscribeGroup(ctx, "suppressions", "Suppressions test", false)
+	if err.Err != nil {
+		t.Fatalf("failed creating the unsubscribe group: %v", err.Err)
+	}
+
+	groupID := fmt.Sprint(group.ID)
+
+	if _, err := c.AddGroupSuppressions(ctx, groupID, []string{"qa@example.com", "legal@example.com"}); err.Err != nil {
+		t.Fatalf("failed adding the group suppressions: %v", err.Err)
+	}
+
+	if err := server.Unsubscribe(groupID, "someone@example.com"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The authoritative resource holds every email of the group after a refresh.
+	r := resourceSendgridUnsubscribeGroupSuppressions()
+	d := r.TestResourceData()
+	d.SetId(groupID)
+
+	//nolint:errcheck
+	d.Set("group_id", groupID)
+	//nolint:errcheck
+	d.Set("authoritative", true)
+
+	if diags := resourceSendgridUnsubscribeGroupSuppressionsRead(ctx, d, c); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	state := d.State()
+
+	// Leaving the group to its recipients, and dropping legal@example.com from the configuration.
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"group_id":      groupID,
+		"emails":        []interface{}{"qa@example.com", "new@example.com"},
+		"authoritative": false,
+	})
+
+	diff, diffErr := r.SimpleDiff(ctx, state, config, c)
+	if diffErr != nil {
+		t.Fatalf("SimpleDiff() error = %v", diffErr)
+	}
+
+	if _, diags := r.Apply(ctx, state, diff, c); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	emails, err := c.ReadGroupSuppressions(ctx, groupID)
+	if err.Err != nil {
+		t.Fatalf("failed reading the group suppressions: %v", err.Err)
+	}
+
+	for i, email := range emails {
+		emails[i] = strings.ToLower(email)
+	}
+
+	slices.Sort(emails)
+
+	// Nothing is removed from the group, the recipient who unsubscribed themselves included.
+	want := []string{"legal@example.com", "new@example.com", "qa@example.com", "someone@example.com"}
+	if !slices.Equal(emails, want) {
+		t.Errorf("group suppressions = %v, want %v", emails, want)
+	}
+
+	for _, r := range server.Requests() {
+		if r.Method == "DELETE" {
+			t.Errorf("unexpected request %s %s", r.Method, r.Path)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+/*
+Provide a resource to manage a verified sender, or single sender identity.
+Example Usage
+```hcl
+
+	resource "sendgrid_verified_sender" "support" {
+		nickname   = "Support"
+		from_email = "support@example.com"
+		from_name  = "Example Support"
+		reply_to   = "support@example.com"
+		address    = "1 Example Street"
+		city       = "Denver"
+		country    = "United States"
+	}
+
+```
+SendGrid emails the from address a link to verify it. Change verification_trigger
+to send the email again.
+Import
+A verified sender can be imported by its ID, e.g.
+```hcl
+$ terraform import sendgrid_verified_sender.support senderId
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSendgridVerifiedSender() *schema.Resource { //nolint:funlen
+	return &schema.Resource{
+		CreateContext: resourceSendgridVerifiedSenderCreate,
+		ReadContext:   resourceSendgridVerifiedSenderRead,
+		UpdateContext: resourceSendgridVerifiedSenderUpdate,
+		DeleteContext: resourceSendgridVerifiedSenderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Read:   schema.DefaultTimeout(defaultReadTimeout),
+			Update: schema.DefaultTimeout(defaultUpdateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nickname": {
+				Type:         schema.TypeString,
+				Description:  "A nickname for the sender, only shown in SendGrid.",
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, maxStringLength),
+			},
+			"from_email": {
+				Type:        schema.TypeString,
+				Description: "The email address to send from. Changing it requires to verify the sender again.",
+				Required:    true,
+			},
+			"from_name": {
+				Type:        schema.TypeString,
+				Description: "The name shown as sender.",
+				Optional:    true,
+			},
+			"reply_to": {
+				Type:        schema.TypeString,
+				Description: "The email address replies are sent to.",
+				Required:    true,
+			},
+			"reply_to_name": {
+				Type:        schema.TypeString,
+				Description: "The name replies are sent to.",
+				Optional:    true,
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Description: "The physical address of the sender, required by anti-spam laws.",
+				Required:    true,
+			},
+			"address2": {
+				Type:        schema.TypeString,
+				Description: "The second line of the address of the sender.",
+				Optional:    true,
+			},
+			"city": {
+				Type:        schema.TypeString,
+				Description: "The city of the sender.",
+				Required:    true,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "The state of the sender.",
+				Optional:    true,
+			},
+			"zip": {
+				Type:        schema.TypeString,
+				Description: "The zip code of the sender.",
+				Optional:    true,
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Description: "The country of the sender.",
+				Required:    true,
+			},
+			"verification_trigger": {
+				Type: schema.TypeString,
+				Description: "Arbitrary value. Changing it sends the verification email again " +
+					"while the sender is not verified, e.g. after the link expired.",
+				Optional: true,
+			},
+			"verified": {
+				Type:        schema.TypeBool,
+				Description: "True once the from address was verified.",
+				Computed:    true,
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Description: "True while the sender is used by a campaign and can't be changed.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// verifiedSenderOf returns the sender described by the arguments of the resource.
+func verifiedSenderOf(d *schema.ResourceData) sendgrid.VerifiedSender {
+	return sendgrid.VerifiedSender{
+		Nickname:    d.Get("nickname").(string),
+		FromEmail:   d.Get("from_email").(string),
+		FromName:    d.Get("from_name").(string),
+		ReplyTo:     d.Get("reply_to").(string),
+		ReplyToName: d.Get("reply_to_name").(string),
+		Address:     d.Get("address").(string),
+		Address2:    d.Get("address2").(string),
+		City:        d.Get("city").(string),
+		State:       d.Get("state").(string),
+		Zip:         d.Get("zip").(string),
+		Country:     d.Get("country").(string),
+	}
+}
+
+func resourceSendgridVerifiedSenderCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	sender := verifiedSenderOf(d)
+
+	senderStruct, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, sendgrid.RequestError) {
+		return c.CreateVerifiedSender(ctx, sender)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprint(senderStruct.(*sendgrid.VerifiedSender).ID))
+
+	return resourceSendgridVerifiedSenderRead(ctx, d, m)
+}
+
+func resourceSendgridVerifiedSenderRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	sender, err := c.ReadVerifiedSender(ctx, d.Id())
+	if err.Err != nil {
+		return readDiagnostics(ctx, d, "sendgrid_verified_sender", err.Err)
+	}
+
+	//nolint:errcheck
+	d.Set("nickname", sender.Nickname)
+	//nolint:errcheck
+	d.Set("from_email", sender.FromEmail)
+	//nolint:errcheck
+	d.Set("from_name", sender.FromName)
+	//nolint:errcheck
+	d.Set("reply_to", sender.ReplyTo)
+	//nolint:errcheck
+	d.Set("reply_to_name", sender.ReplyToName)
+	//nolint:errcheck
+	d.Set("address", sender.Address)
+	//nolint:errcheck
+	d.Set("address2", sender.Address2)
+	//nolint:errcheck
+	d.Set("city", sender.City)
+	//nolint:errcheck
+	d.Set("state", sender.State)
+	//nolint:errcheck
+	d.Set("zip", sender.Zip)
+	//nolint:errcheck
+	d.Set("country", sender.Country)
+	//nolint:errcheck
+	d.Set("verified", sender.Verified)
+	//nolint:errcheck
+	d.Set("locked", sender.Locked)
+
+	return nil
+}
+
+func resourceSendgridVerifiedSenderUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	if d.HasChangesExcept("verification_trigger") {
+		sender := verifiedSenderOf(d)
+
+		_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
+			return c.UpdateVerifiedSender(ctx, d.Id(), sender)
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("verification_trigger") {
+		if diags := resourceSendgridVerifiedSenderResend(ctx, d, c); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceSendgridVerifiedSenderRead(ctx, d, m)
+}
+
+// resourceSendgridVerifiedSenderResend sends the verification email again, unless the sender is verified.
+func resourceSendgridVerifiedSenderResend(ctx context.Context, d *schema.ResourceData, c *sendgrid.Client) diag.Diagnostics {
+	sender, err := c.ReadVerifiedSender(ctx, d.Id())
+	if err.Err != nil {
+		return diag.FromErr(err.Err)
+	}
+
+	if sender.Verified {
+		tflog.Info(ctx, "Sender already verified, not sending the verification email", map[string]interface{}{
+			"id":         d.Id(),
+			"from_email": sender.FromEmail,
+		})
+
+		return nil
+	}
+
+	_, resendErr := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, sendgrid.RequestError) {
+		return c.ResendSenderVerification(ctx, d.Id())
+	})
+
+	return diag.FromErr(resendErr)
+}
+
+func resourceSendgridVerifiedSenderDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client)
+
+	_, err := sendgrid.RetryOnRateLimit(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteVerifiedSender(ctx, d.Id())
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
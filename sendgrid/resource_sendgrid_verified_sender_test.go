@@ -0,0 +1,147 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/arslanbekov/terraform-provider-sendgrid/sdk/sendgridtest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSendgridVerifiedSenderBasic(t *testing.T) {
+	email := "terraform-sender-" + acctest.RandString(10) + "@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridVerifiedSenderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridVerifiedSenderConfig("Support", email, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_verified_sender.test", "from_email", email),
+					resource.TestCheckResourceAttr("sendgrid_verified_sender.test", "verified", "false"),
+				),
+			},
+			{
+				ResourceName:            "sendgrid_verified_sender.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verification_trigger"},
+			},
+		},
+	})
+}
+
+func TestUnitSendgridVerifiedSender(t *testing.T) {
+	server := sendgridtest.NewServer()
+	defer server.Close()
+
+	email := "terraform-sender-" + acctest.RandString(10) + "@example.com"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSendgridVerifiedSenderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testUnitProviderConfig(server) + testAccCheckSendgridVerifiedSenderConfig("Support", email, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_verified_sender.test", "nickname", "Support"),
+					resource.TestCheckResourceAttr("sendgrid_verified_sender.test", "verified", "false"),
+					resource.TestCheckResourceAttr("data.sendgrid_verified_senders.pending", "senders.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_verified_senders.pending", "senders.0.from_email", email),
+				),
+			},
+			{
+				// Changing the trigger sends the verification email again.
+				Config: testUnitProviderConfig(server) + testAccCheckSendgridVerifiedSenderConfig("Support", email, "2"),
+				Check:  testUnitCheckVerificationResent(server, 1),
+			},
+			{
+				// The sender was verified outside of Terraform, and is renamed.
+				PreConfig: func() {
+					if err := server.VerifySender(email); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testUnitProviderConfig(server) + testAccCheckSendgridVerifiedSenderConfig("Support team", email, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_verified_sender.test", "nickname", "Support team"),
+					resource.TestCheckResourceAttr("sendgrid_verified_sender.test", "verified", "true"),
+					resource.TestCheckResourceAttr("data.sendgrid_verified_senders.pending", "senders.#", "0"),
+				),
+			},
+			{
+				// A verified sender doesn't get the verification email again.
+				Config: testUnitProviderConfig(server) + testAccCheckSendgridVerifiedSenderConfig("Support team", email, "3"),
+				Check:  testUnitCheckVerificationResent(server, 1),
+			},
+			{
+				ResourceName:            "sendgrid_verified_sender.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verification_trigger"},
+			},
+		},
+	})
+}
+
+func testUnitCheckVerificationResent(server *sendgridtest.Server, want int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		count := 0
+
+		for _, r := range server.Requests() {
+			if r.Method == "POST" && strings.HasPrefix(r.Path, "/verified_senders/resend/") {
+				count++
+			}
+		}
+
+		if count != want {
+			return fmt.Errorf("verification email sent again %d times, want %d", count, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSendgridVerifiedSenderDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_verified_sender" {
+			continue
+		}
+
+		if _, err := c.ReadVerifiedSender(context.Background(), rs.Primary.ID); err.StatusCode != 404 {
+			return fmt.Errorf("verified sender still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridVerifiedSenderConfig(nickname, email, trigger string) string {
+	return fmt.Sprintf(`
+resource "sendgrid_verified_sender" "test" {
+	nickname             = %q
+	from_email           = %q
+	from_name            = "Example Support"
+	reply_to             = %q
+	address              = "1 Example Street"
+	city                 = "Denver"
+	country              = "United States"
+	verification_trigger = %q
+}
+
+data "sendgrid_verified_senders" "pending" {
+	verified   = false
+	depends_on = [sendgrid_verified_sender.test]
+}
+`, nickname, email, email, trigger)
+}
@@ -0,0 +1,79 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sendgrid "github.com/arslanbekov/terraform-provider-sendgrid/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The settings of an account, e.g. the mail and tracking settings, are
+// singletons: SendGrid has one per account and subuser, which can't be created or
+// deleted. Their resources change them on create and update.
+
+// singletonID returns the ID of a setting: the subuser it is managed for, or
+// "default" for the parent account.
+func singletonID(c *sendgrid.Client) string {
+	if c.OnBehalfOf != "" {
+		return c.OnBehalfOf
+	}
+
+	return "default"
+}
+
+// importSingleton imports a setting with the ID Terraform gives it on create.
+func importSingleton(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*sendgrid.Client)
+
+	if want := singletonID(c); d.Id() != want {
+		return nil, fmt.Errorf("%w: got %q, want %q", ErrInvalidSingletonImportID, d.Id(), want)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// singletonTimeout returns the timeout of a change of a setting, which is a create
+// for a new resource.
+func singletonTimeout(d *schema.ResourceData) time.Duration {
+	if d.IsNewResource() {
+		return d.Timeout(schema.TimeoutCreate)
+	}
+
+	return d.Timeout(schema.TimeoutUpdate)
+}
+
+// mailSettingDelete returns a delete function turning the mail setting off. Its other
+// fields are left as is.
+func mailSettingDelete(setting sendgrid.MailSetting) schema.DeleteContextFunc {
+	return settingDelete(func(ctx context.Context, c *sendgrid.Client) (bool, sendgrid.RequestError) {
+		return c.DisableMailSetting(ctx, setting)
+	})
+}
+
+// trackingSettingDelete returns a delete function turning the tracking setting off.
+// Its other fields are left as is.
+func trackingSettingDelete(setting sendgrid.TrackingSetting) schema.DeleteContextFunc {
+	return settingDelete(func(ctx context.Context, c *sendgrid.Client) (bool, sendgrid.RequestError) {
+		return c.DisableTrackingSetting(ctx, setting)
+	})
+}
+
+// settingDelete returns a delete function turning a setting off with disable.
+func settingDelete(
+	disable func(context.Context, *sendgrid.Client) (bool, sendgrid.RequestError),
+) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		c := m.(*sendgrid.Client)
+
+		timeout := d.Timeout(schema.TimeoutDelete)
+
+		_, err := sendgrid.RetryOnRateLimit(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+			return disable(ctx, c)
+		})
+
+		return diag.FromErr(err)
+	}
+}
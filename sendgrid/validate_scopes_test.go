@@ -3,63 +3,60 @@ package sendgrid
 import (
 	"testing"
 
-	"github.com/hashicorp/go-cty/cty"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 func TestValidateTeammateScopes(t *testing.T) {
 	tests := []struct {
 		name          string
-		scopes        []interface{}
+		scopes        []string
 		expectErrors  bool
 		errorContains string
 	}{
 		{
 			name:         "valid scopes",
-			scopes:       []interface{}{"mail.send", "templates.read", "stats.read"},
+			scopes:       []string{"mail.send", "templates.read", "stats.read"},
 			expectErrors: false,
 		},
 		{
 			name:          "invalid scope",
-			scopes:        []interface{}{"mail.send", "invalid.scope"},
+			scopes:        []string{"mail.send", "invalid.scope"},
 			expectErrors:  true,
 			errorContains: "not valid or assignable",
 		},
 		{
 			name:          "automatic scope 2fa_exempt",
-			scopes:        []interface{}{"mail.send", "2fa_exempt"},
+			scopes:        []string{"mail.send", "2fa_exempt"},
 			expectErrors:  true,
 			errorContains: "set automatically by SendGrid",
 		},
 		{
 			name:          "automatic scope 2fa_required",
-			scopes:        []interface{}{"mail.send", "2fa_required"},
+			scopes:        []string{"mail.send", "2fa_required"},
 			expectErrors:  true,
 			errorContains: "set automatically by SendGrid",
 		},
 		{
 			name:          "mix of valid and invalid",
-			scopes:        []interface{}{"mail.send", "invalid.scope", "templates.read"},
+			scopes:        []string{"mail.send", "invalid.scope", "templates.read"},
 			expectErrors:  true,
 			errorContains: "not valid or assignable",
 		},
 		{
 			name:         "marketing scopes",
-			scopes:       []interface{}{"marketing.read", "marketing.automation.read"},
+			scopes:       []string{"marketing.read", "marketing.automation.read"},
 			expectErrors: false,
 		},
 		{
 			name:         "advanced scopes",
-			scopes:       []interface{}{"subusers.create", "api_keys.read", "teammates.update"},
+			scopes:       []string{"subusers.create", "api_keys.read", "teammates.update"},
 			expectErrors: false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			scopeSet := schema.NewSet(schema.HashString, tt.scopes)
-			path := cty.GetAttrPath("scopes")
-			diags := validateTeammateScopes(scopeSet, path)
+			diags := validateTeammateScopes(tt.scopes, path.Root("scopes"))
 
 			if tt.expectErrors {
 				if !diags.HasError() {
@@ -67,7 +64,7 @@ func TestValidateTeammateScopes(t *testing.T) {
 				} else if tt.errorContains != "" {
 					found := false
 					for _, diag := range diags {
-						if containsString(diag.Detail, tt.errorContains) || containsString(diag.Summary, tt.errorContains) {
+						if containsString(diag.Detail(), tt.errorContains) || containsString(diag.Summary(), tt.errorContains) {
 							found = true
 							break
 						}